@@ -8,6 +8,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -43,6 +44,22 @@ func dataSourceAwsAmi() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			// Shortcuts to look up the official Outscale images, e.g.
+			// family = "Ubuntu-18.04" or os = "centos". The owner is resolved
+			// from the region unless owners is set, and the image with the
+			// highest version is returned.
+			"family": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"os": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			// Computed values.
 			"architecture": {
 				Type:     schema.TypeString,
@@ -84,6 +101,14 @@ func dataSourceAwsAmi() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"os_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -180,9 +205,12 @@ func dataSourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	filters, filtersOk := d.GetOk("filter")
 	nameRegex, nameRegexOk := d.GetOk("name_regex")
 	owners, ownersOk := d.GetOk("owners")
+	family, familyOk := d.GetOk("family")
+	os, osOk := d.GetOk("os")
+	catalog := familyOk || osOk
 
-	if executableUsersOk == false && filtersOk == false && nameRegexOk == false && ownersOk == false {
-		return fmt.Errorf("One of executable_users, filters, name_regex, or owners must be assigned")
+	if executableUsersOk == false && filtersOk == false && nameRegexOk == false && ownersOk == false && !catalog {
+		return fmt.Errorf("One of executable_users, filters, name_regex, owners, family or os must be assigned")
 	}
 
 	params := &ec2.DescribeImagesInput{}
@@ -199,6 +227,17 @@ func dataSourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 			params.Owners = o
 		}
 	}
+	if catalog && len(params.Owners) == 0 {
+		region := meta.(*AWSClient).region
+		alias := OutscaleImageOwnerAliasForRegion(region)
+		if alias == "" {
+			return fmt.Errorf("No official Outscale image owner known for region %q, please set owners", region)
+		}
+		params.Filters = append(params.Filters, &ec2.Filter{
+			Name:   aws.String("owner-alias"),
+			Values: []*string{aws.String(alias)},
+		})
+	}
 
 	resp, err := conn.DescribeImages(params)
 	if err != nil {
@@ -226,6 +265,29 @@ func dataSourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 		filteredImages = resp.Images[:]
 	}
 
+	if catalog {
+		images := filterOutscaleImages(filteredImages, family.(string), os.(string))
+		if len(images) < 1 {
+			return fmt.Errorf("No Outscale image found for family %q and os %q. Please change your search criteria and try again.",
+				family.(string), os.(string))
+		}
+
+		latest := highestVersionOutscaleImage(images)
+		log.Printf("[DEBUG] aws_ami - %d images in the catalog, highest version is %s (%s)",
+			len(images), *latest.Image.ImageId, *latest.Image.Name)
+
+		// Don't override the casing the user chose for the lookup.
+		if !familyOk {
+			d.Set("family", latest.Name.Family())
+		}
+		if !osOk {
+			d.Set("os", latest.Name.OS)
+		}
+		d.Set("os_version", latest.Name.OSVersion)
+		d.Set("version", latest.Name.Version())
+		return amiDescriptionAttributes(d, latest.Image)
+	}
+
 	var image *ec2.Image
 	if len(filteredImages) < 1 {
 		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
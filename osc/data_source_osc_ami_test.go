@@ -171,6 +171,44 @@ func TestAccAWSAmiDataSource_localNameFilter(t *testing.T) {
 	})
 }
 
+func TestAccAWSAmiDataSource_family(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsAmiDataSourceFamilyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAmiDataSourceID("data.aws_ami.ubuntu"),
+					resource.TestCheckResourceAttr("data.aws_ami.ubuntu", "family", "Ubuntu-18.04"),
+					resource.TestCheckResourceAttr("data.aws_ami.ubuntu", "os", "Ubuntu"),
+					resource.TestCheckResourceAttr("data.aws_ami.ubuntu", "os_version", "18.04"),
+					resource.TestMatchResourceAttr("data.aws_ami.ubuntu", "name", regexp.MustCompile("^Ubuntu-18.04-")),
+					resource.TestMatchResourceAttr("data.aws_ami.ubuntu", "version", regexp.MustCompile(`^20[0-9]{2}\.[0-9]{2}\.[0-9]{2}-[0-9]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAmiDataSource_os(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsAmiDataSourceOsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAmiDataSourceID("data.aws_ami.centos"),
+					resource.TestCheckResourceAttr("data.aws_ami.centos", "os", "centos"),
+					resource.TestMatchResourceAttr("data.aws_ami.centos", "family", regexp.MustCompile("^CentOS-")),
+					resource.TestMatchResourceAttr("data.aws_ami.centos", "name", regexp.MustCompile("^CentOS-")),
+				),
+			},
+		},
+	})
+}
+
 func TestResourceValidateNameRegex(t *testing.T) {
 	type testCases struct {
 		Value    string
@@ -348,3 +386,15 @@ data "aws_ami" "name_regex_filtered_ami" {
 	name_regex = "^amzn-ami-\\d{3}[5].*-ecs-optimized"
 }
 `
+
+const testAccCheckAwsAmiDataSourceFamilyConfig = `
+data "aws_ami" "ubuntu" {
+  family = "Ubuntu-18.04"
+}
+`
+
+const testAccCheckAwsAmiDataSourceOsConfig = `
+data "aws_ami" "centos" {
+  os = "centos"
+}
+`
@@ -0,0 +1,106 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsCustomerGateway() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCustomerGatewayRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"tags":   tagsSchemaComputed(),
+			"bgp_asn": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	req := &ec2.DescribeCustomerGatewaysInput{}
+
+	if id, ok := d.GetOk("id"); ok {
+		req.CustomerGatewayIds = []*string{aws.String(id.(string))}
+	}
+
+	req.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"ip-address": d.Get("ip_address").(string),
+		},
+	)
+	req.Filters = append(req.Filters, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Describe Customer Gateways %v\n", req)
+	resp, err := conn.DescribeCustomerGateways(req)
+	if err != nil {
+		return err
+	}
+
+	// Deleted gateways are listed for a while after their deletion.
+	var gateways []*ec2.CustomerGateway
+	for _, cgw := range resp.CustomerGateways {
+		if aws.StringValue(cgw.State) == "deleted" {
+			continue
+		}
+		gateways = append(gateways, cgw)
+	}
+
+	if len(gateways) == 0 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+	if len(gateways) > 1 {
+		return fmt.Errorf("Multiple Customer Gateways matched; use additional constraints to reduce matches to a single Customer Gateway")
+	}
+
+	cgw := gateways[0]
+
+	d.SetId(aws.StringValue(cgw.CustomerGatewayId))
+	d.Set("id", cgw.CustomerGatewayId)
+	d.Set("ip_address", cgw.IpAddress)
+	d.Set("type", cgw.Type)
+	d.Set("tags", tagsToMap(cgw.Tags))
+
+	if aws.StringValue(cgw.BgpAsn) != "" {
+		val, err := strconv.ParseInt(*cgw.BgpAsn, 0, 0)
+		if err != nil {
+			return fmt.Errorf("error parsing bgp_asn: %s", err)
+		}
+
+		d.Set("bgp_asn", int(val))
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsCustomerGateway_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsCustomerGatewayConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aws_customer_gateway.by_id", "id",
+						"aws_customer_gateway.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.aws_customer_gateway.by_id", "bgp_asn", "65000"),
+					resource.TestCheckResourceAttr(
+						"data.aws_customer_gateway.by_id", "type", "ipsec.1"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_customer_gateway.by_filter", "id",
+						"aws_customer_gateway.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsCustomerGatewayConfig = `
+resource "aws_customer_gateway" "test" {
+  bgp_asn = 65000
+  ip_address = "178.0.0.12"
+  type = "ipsec.1"
+
+  tags {
+    Name = "terraform-testacc-cgw-data-source"
+  }
+}
+
+data "aws_customer_gateway" "by_id" {
+  id = "${aws_customer_gateway.test.id}"
+}
+
+data "aws_customer_gateway" "by_filter" {
+  filter {
+    name = "tag:Name"
+    values = ["${aws_customer_gateway.test.tags["Name"]}"]
+  }
+}
+`
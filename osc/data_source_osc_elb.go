@@ -0,0 +1,354 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// DescribeTags accepts at most 20 load balancer names per call.
+const elbDescribeTagsBatchSize = 20
+
+func dataSourceAwsElb() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsElbRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": tagsSchemaComputed(),
+
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"internal": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"instances": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"source_security_group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subnets": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"cross_zone_load_balancing": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"idle_timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"connection_draining": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"connection_draining_timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"access_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bucket_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"listener": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"instance_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lb_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"lb_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ssl_certificate_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Set: resourceAwsElbListenerHash,
+			},
+
+			"health_check": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"healthy_threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"unhealthy_threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"target": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
+	elbconn := meta.(*AWSClient).elbconn
+
+	var elbName string
+	if v, ok := d.GetOk("name"); ok {
+		elbName = v.(string)
+	} else {
+		tags := d.Get("tags").(map[string]interface{})
+		if len(tags) == 0 {
+			return fmt.Errorf("One of name or tags must be set to look up an ELB")
+		}
+
+		names, err := elbNamesByTags(elbconn, tagsFromMapELB(tags))
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			return fmt.Errorf("no matching ELB found")
+		}
+		if len(names) > 1 {
+			return fmt.Errorf("multiple ELBs matched; use additional constraints to reduce matches to a single ELB")
+		}
+		elbName = names[0]
+	}
+
+	log.Printf("[DEBUG] Reading ELB: %s", elbName)
+	resp, err := elbconn.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
+		LoadBalancerNames: []*string{aws.String(elbName)},
+	})
+	if err != nil {
+		if isLoadBalancerNotFound(err) {
+			return fmt.Errorf("no matching ELB found")
+		}
+		return fmt.Errorf("Error retrieving ELB %q: %s", elbName, err)
+	}
+	if len(resp.LoadBalancerDescriptions) != 1 {
+		return fmt.Errorf("Unable to find ELB: %#v", resp.LoadBalancerDescriptions)
+	}
+	lb := resp.LoadBalancerDescriptions[0]
+
+	attrsResp, err := elbconn.DescribeLoadBalancerAttributes(&elb.DescribeLoadBalancerAttributesInput{
+		LoadBalancerName: aws.String(elbName),
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving ELB %q attributes: %s", elbName, err)
+	}
+	lbAttrs := attrsResp.LoadBalancerAttributes
+
+	d.SetId(*lb.LoadBalancerName)
+	d.Set("name", lb.LoadBalancerName)
+	d.Set("dns_name", lb.DNSName)
+	d.Set("zone_id", lb.CanonicalHostedZoneNameID)
+
+	var scheme bool
+	if lb.Scheme != nil {
+		scheme = *lb.Scheme == "internal"
+	}
+	d.Set("internal", scheme)
+	d.Set("availability_zones", flattenStringList(lb.AvailabilityZones))
+	d.Set("instances", flattenInstances(lb.Instances))
+	d.Set("security_groups", flattenStringList(lb.SecurityGroups))
+	d.Set("subnets", flattenStringList(lb.Subnets))
+	if err := d.Set("listener", flattenListeners(lb.ListenerDescriptions)); err != nil {
+		return err
+	}
+
+	if lb.SourceSecurityGroup != nil {
+		group := lb.SourceSecurityGroup.GroupName
+		if lb.SourceSecurityGroup.OwnerAlias != nil && *lb.SourceSecurityGroup.OwnerAlias != "" {
+			group = aws.String(*lb.SourceSecurityGroup.OwnerAlias + "/" + *lb.SourceSecurityGroup.GroupName)
+		}
+		d.Set("source_security_group", group)
+
+		if lb.VPCId != nil {
+			sgId, err := sourceSGIdByName(meta, *lb.SourceSecurityGroup.GroupName, *lb.VPCId)
+			if err != nil {
+				return fmt.Errorf("Error looking up ELB Security Group ID: %s", err)
+			}
+			d.Set("source_security_group_id", sgId)
+		}
+	}
+
+	if lbAttrs.CrossZoneLoadBalancing != nil {
+		d.Set("cross_zone_load_balancing", lbAttrs.CrossZoneLoadBalancing.Enabled)
+	}
+	if lbAttrs.ConnectionSettings != nil {
+		d.Set("idle_timeout", lbAttrs.ConnectionSettings.IdleTimeout)
+	}
+	if lbAttrs.ConnectionDraining != nil {
+		d.Set("connection_draining", lbAttrs.ConnectionDraining.Enabled)
+		d.Set("connection_draining_timeout", lbAttrs.ConnectionDraining.Timeout)
+	}
+	if err := d.Set("access_logs", flattenAccessLog(lbAttrs.AccessLog)); err != nil {
+		return err
+	}
+
+	if lb.HealthCheck != nil && lb.HealthCheck.Target != nil && *lb.HealthCheck.Target != "" {
+		if err := d.Set("health_check", flattenHealthCheck(lb.HealthCheck)); err != nil {
+			return err
+		}
+	}
+
+	tagsResp, err := elbconn.DescribeTags(&elb.DescribeTagsInput{
+		LoadBalancerNames: []*string{lb.LoadBalancerName},
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving ELB %q tags: %s", elbName, err)
+	}
+	var et []*elb.Tag
+	if len(tagsResp.TagDescriptions) > 0 {
+		et = tagsResp.TagDescriptions[0].Tags
+	}
+	d.Set("tags", tagsToMapELB(et))
+
+	return nil
+}
+
+// elbNamesByTags returns the names of all load balancers carrying every
+// one of the given tags.
+func elbNamesByTags(conn *elb.ELB, tags []*elb.Tag) ([]string, error) {
+	var all []*string
+	err := conn.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{},
+		func(page *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+			for _, lb := range page.LoadBalancerDescriptions {
+				all = append(all, lb.LoadBalancerName)
+			}
+			return !lastPage
+		})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing ELBs: %s", err)
+	}
+
+	var names []string
+	for i := 0; i < len(all); i += elbDescribeTagsBatchSize {
+		j := i + elbDescribeTagsBatchSize
+		if j > len(all) {
+			j = len(all)
+		}
+
+		resp, err := conn.DescribeTags(&elb.DescribeTagsInput{
+			LoadBalancerNames: all[i:j],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving ELB tags: %s", err)
+		}
+
+		for _, td := range resp.TagDescriptions {
+			if elbTagsContain(td.Tags, tags) {
+				names = append(names, *td.LoadBalancerName)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// elbTagsContain reports whether every tag in want is present in have
+// with the same value.
+func elbTagsContain(have, want []*elb.Tag) bool {
+	m := tagsToMapELB(have)
+	for _, t := range want {
+		if v, ok := m[*t.Key]; !ok || v != *t.Value {
+			return false
+		}
+	}
+	return true
+}
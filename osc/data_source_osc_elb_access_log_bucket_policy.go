@@ -0,0 +1,102 @@
+package osc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsElbAccessLogBucketPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsElbAccessLogBucketPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"bucket_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_account_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsElbAccessLogBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+
+	region := client.region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	accid := LBUServiceAccountIdForRegion(region)
+	if accid == "" {
+		return fmt.Errorf("Unknown region (%q)", region)
+	}
+	principal := fmt.Sprintf("arn:%s:iam::%s:root", client.partition, accid)
+
+	doc := elbAccessLogBucketPolicy(client.partition, principal,
+		d.Get("bucket").(string), d.Get("bucket_prefix").(string), client.accountid)
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	jsonString := string(jsonDoc)
+
+	d.Set("service_account_arn", principal)
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(hashcode.String(jsonString)))
+
+	return nil
+}
+
+// elbAccessLogBucketPolicy builds the bucket policy allowing the load
+// balancer service account to deliver access logs under the given prefix.
+// Logs are written below AWSLogs/<account id>/, which narrows the grant
+// when the account of the load balancer is known.
+func elbAccessLogBucketPolicy(partition, principal, bucket, prefix, accountId string) *IAMPolicyDoc {
+	path := bucket
+	if prefix = strings.Trim(prefix, "/"); prefix != "" {
+		path = path + "/" + prefix
+	}
+	if accountId != "" {
+		path = path + "/AWSLogs/" + accountId
+	}
+
+	return &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{
+				Sid:       "AllowELBAccessLogDelivery",
+				Effect:    "Allow",
+				Actions:   "s3:PutObject",
+				Resources: fmt.Sprintf("arn:%s:s3:::%s/*", partition, path),
+				Principals: IAMPolicyStatementPrincipalSet{
+					{
+						Type:        "AWS",
+						Identifiers: principal,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package osc
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestElbAccessLogBucketPolicy(t *testing.T) {
+	cases := []struct {
+		Prefix    string
+		AccountId string
+		Resource  string
+	}{
+		{
+			Prefix:    "",
+			AccountId: "",
+			Resource:  "arn:aws:s3:::my-logs/*",
+		},
+		{
+			Prefix:    "/lb/",
+			AccountId: "",
+			Resource:  "arn:aws:s3:::my-logs/lb/*",
+		},
+		{
+			Prefix:    "lb",
+			AccountId: "123456789012",
+			Resource:  "arn:aws:s3:::my-logs/lb/AWSLogs/123456789012/*",
+		},
+	}
+
+	for _, tc := range cases {
+		doc := elbAccessLogBucketPolicy("aws", "arn:aws:iam::797873946194:root", "my-logs", tc.Prefix, tc.AccountId)
+		raw, err := json.Marshal(doc)
+		if err != nil {
+			t.Fatalf("bad: %s", err)
+		}
+
+		var policy struct {
+			Statement []struct {
+				Effect    string
+				Action    string
+				Resource  string
+				Principal map[string]string
+			}
+		}
+		if err := json.Unmarshal(raw, &policy); err != nil {
+			t.Fatalf("bad: %s", err)
+		}
+
+		if len(policy.Statement) != 1 {
+			t.Fatalf("expected 1 statement, got %d", len(policy.Statement))
+		}
+		stmt := policy.Statement[0]
+		if stmt.Effect != "Allow" || stmt.Action != "s3:PutObject" {
+			t.Fatalf("unexpected statement: %s", raw)
+		}
+		if stmt.Resource != tc.Resource {
+			t.Fatalf("expected resource %q, got %q", tc.Resource, stmt.Resource)
+		}
+		if stmt.Principal["AWS"] != "arn:aws:iam::797873946194:root" {
+			t.Fatalf("unexpected principal: %s", raw)
+		}
+	}
+}
+
+func TestAccAWSElbAccessLogBucketPolicy_basic(t *testing.T) {
+	region := os.Getenv("AWS_DEFAULT_REGION")
+	accid := LBUServiceAccountIdForRegion(region)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if accid == "" {
+				t.Skipf("LBU service account of region %q is unknown", region)
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsElbAccessLogBucketPolicyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_elb_access_log_bucket_policy.main", "service_account_arn", fmt.Sprintf("arn:aws:iam::%s:root", accid)),
+					resource.TestCheckResourceAttrSet("data.aws_elb_access_log_bucket_policy.main", "json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSElbAccessLogBucketPolicy_unknownRegion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAwsElbAccessLogBucketPolicyConfigUnknownRegion,
+				ExpectError: regexp.MustCompile(`Unknown region`),
+			},
+		},
+	})
+}
+
+const testAccCheckAwsElbAccessLogBucketPolicyConfig = `
+data "aws_elb_access_log_bucket_policy" "main" {
+  bucket        = "tf-test-elb-logs"
+  bucket_prefix = "lb"
+}
+`
+
+const testAccCheckAwsElbAccessLogBucketPolicyConfigUnknownRegion = `
+data "aws_elb_access_log_bucket_policy" "regional" {
+  bucket = "tf-test-elb-logs"
+  region = "xx-unknown-1"
+}
+`
@@ -0,0 +1,114 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsElbInstanceHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsElbInstanceHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"instances": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"in_service_instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"instance_states": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsElbInstanceHealthRead(d *schema.ResourceData, meta interface{}) error {
+	elbconn := meta.(*AWSClient).elbconn
+	elbName := d.Get("name").(string)
+
+	req := &elb.DescribeInstanceHealthInput{
+		LoadBalancerName: aws.String(elbName),
+	}
+	if v, ok := d.GetOk("instances"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			req.Instances = append(req.Instances, &elb.Instance{
+				InstanceId: aws.String(id.(string)),
+			})
+		}
+	}
+
+	log.Printf("[DEBUG] Reading ELB instance health: %s", req)
+	resp, err := elbconn.DescribeInstanceHealth(req)
+	if err != nil {
+		if isLoadBalancerNotFound(err) {
+			return fmt.Errorf("no matching ELB found")
+		}
+		return fmt.Errorf("Error retrieving ELB %q instance health: %s", elbName, err)
+	}
+
+	states := resp.InstanceStates
+	sort.Slice(states, func(i, j int) bool {
+		return aws.StringValue(states[i].InstanceId) < aws.StringValue(states[j].InstanceId)
+	})
+
+	inService := make([]string, 0, len(states))
+	instanceStates := make([]map[string]interface{}, 0, len(states))
+	for _, s := range states {
+		if aws.StringValue(s.State) == "InService" {
+			inService = append(inService, aws.StringValue(s.InstanceId))
+		}
+		instanceStates = append(instanceStates, map[string]interface{}{
+			"instance_id": aws.StringValue(s.InstanceId),
+			"state":       aws.StringValue(s.State),
+			"reason_code": aws.StringValue(s.ReasonCode),
+			"description": aws.StringValue(s.Description),
+		})
+	}
+
+	d.SetId(elbName)
+	d.Set("in_service_instances", inService)
+	if err := d.Set("instance_states", instanceStates); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAWSELBInstanceHealth_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSELBInstanceHealthConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aws_elb_instance_health.test", "id", "aws_elb.bar", "name"),
+					resource.TestCheckResourceAttr("data.aws_elb_instance_health.test", "instance_states.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_elb_instance_health.test", "instance_states.0.instance_id", "aws_instance.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.aws_elb_instance_health.test", "instance_states.0.state"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAWSELBInstanceHealthConfig = `
+resource "aws_elb" "bar" {
+  availability_zones = ["us-west-2a", "us-west-2b", "us-west-2c"]
+
+  listener {
+    instance_port = 8000
+    instance_protocol = "http"
+    lb_port = 80
+    lb_protocol = "http"
+  }
+
+  instances = ["${aws_instance.foo.id}"]
+}
+
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-043a5034"
+	instance_type = "t1.micro"
+}
+
+data "aws_elb_instance_health" "test" {
+  name = "${aws_elb.bar.name}"
+}
+`
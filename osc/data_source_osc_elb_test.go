@@ -0,0 +1,113 @@
+package osc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAWSELB_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-elb-ds-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSELBConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aws_elb.by_name", "id", "aws_elb.test", "id"),
+					resource.TestCheckResourceAttrPair("data.aws_elb.by_name", "dns_name", "aws_elb.test", "dns_name"),
+					resource.TestCheckResourceAttr("data.aws_elb.by_name", "listener.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_elb.by_name", "listener.206423021.instance_port", "8000"),
+					resource.TestCheckResourceAttr("data.aws_elb.by_name", "listener.206423021.lb_port", "80"),
+					resource.TestCheckResourceAttr("data.aws_elb.by_name", "health_check.0.target", "HTTP:8000/"),
+					resource.TestCheckResourceAttr("data.aws_elb.by_name", "idle_timeout", "200"),
+					resource.TestCheckResourceAttr("data.aws_elb.by_name", "tags.Name", rName),
+					resource.TestCheckResourceAttrPair("data.aws_elb.by_tags", "id", "aws_elb.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestElbTagsContain(t *testing.T) {
+	have := []*elb.Tag{
+		{Key: aws.String("Name"), Value: aws.String("web")},
+		{Key: aws.String("env"), Value: aws.String("prod")},
+	}
+
+	cases := []struct {
+		Want     []*elb.Tag
+		Expected bool
+	}{
+		{
+			Want:     nil,
+			Expected: true,
+		},
+		{
+			Want:     []*elb.Tag{{Key: aws.String("env"), Value: aws.String("prod")}},
+			Expected: true,
+		},
+		{
+			Want:     []*elb.Tag{{Key: aws.String("env"), Value: aws.String("dev")}},
+			Expected: false,
+		},
+		{
+			Want: []*elb.Tag{
+				{Key: aws.String("Name"), Value: aws.String("web")},
+				{Key: aws.String("team"), Value: aws.String("ops")},
+			},
+			Expected: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if got := elbTagsContain(have, tc.Want); got != tc.Expected {
+			t.Fatalf("%d: expected %t, got %t", i, tc.Expected, got)
+		}
+	}
+}
+
+func testAccDataSourceAWSELBConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elb" "test" {
+  name = "%s"
+  availability_zones = ["us-west-2a", "us-west-2b"]
+  idle_timeout = 200
+
+  listener {
+    instance_port = 8000
+    instance_protocol = "http"
+    lb_port = 80
+    lb_protocol = "http"
+  }
+
+  health_check {
+    healthy_threshold = 2
+    unhealthy_threshold = 2
+    target = "HTTP:8000/"
+    interval = 30
+    timeout = 5
+  }
+
+  tags {
+    Name = "%s"
+  }
+}
+
+data "aws_elb" "by_name" {
+  name = "${aws_elb.test.name}"
+}
+
+data "aws_elb" "by_tags" {
+  tags {
+    Name = "${aws_elb.test.tags["Name"]}"
+  }
+}
+`, rName, rName)
+}
@@ -0,0 +1,78 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsInternetGateway() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsInternetGatewayRead,
+
+		Schema: map[string]*schema.Schema{
+			"internet_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"tags":   tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	req := &ec2.DescribeInternetGatewaysInput{}
+
+	req.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"internet-gateway-id": d.Get("internet_gateway_id").(string),
+			"attachment.vpc-id":   d.Get("vpc_id").(string),
+		},
+	)
+	req.Filters = append(req.Filters, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Describe Internet Gateways %v\n", req)
+	resp, err := conn.DescribeInternetGateways(req)
+	if err != nil {
+		return err
+	}
+	if resp == nil || len(resp.InternetGateways) == 0 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+	if len(resp.InternetGateways) > 1 {
+		return fmt.Errorf("Multiple Internet Gateways matched; use additional constraints to reduce matches to a single Internet Gateway")
+	}
+
+	ig := resp.InternetGateways[0]
+
+	d.SetId(aws.StringValue(ig.InternetGatewayId))
+	d.Set("internet_gateway_id", ig.InternetGatewayId)
+	if len(ig.Attachments) == 0 {
+		d.Set("vpc_id", "")
+	} else {
+		d.Set("vpc_id", ig.Attachments[0].VpcId)
+	}
+	d.Set("tags", tagsToMap(ig.Tags))
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsInternetGateway_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsInternetGatewayConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aws_internet_gateway.by_id", "internet_gateway_id",
+						"aws_internet_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_internet_gateway.by_id", "vpc_id",
+						"aws_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_internet_gateway.by_vpc_id", "internet_gateway_id",
+						"aws_internet_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_internet_gateway.by_tag", "internet_gateway_id",
+						"aws_internet_gateway.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.aws_internet_gateway.by_tag", "tags.Name", "terraform-testacc-igw-data-source"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsInternetGatewayConfig = `
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "terraform-testacc-igw-data-source"
+  }
+}
+
+data "aws_internet_gateway" "by_id" {
+  internet_gateway_id = "${aws_internet_gateway.test.id}"
+}
+
+data "aws_internet_gateway" "by_vpc_id" {
+  vpc_id = "${aws_internet_gateway.test.vpc_id}"
+}
+
+data "aws_internet_gateway" "by_tag" {
+  tags {
+    Name = "${aws_internet_gateway.test.tags["Name"]}"
+  }
+}
+`
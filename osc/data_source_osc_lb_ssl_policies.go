@@ -0,0 +1,145 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLBSSLPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLBSSLPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocols": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ciphers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"server_defined_cipher_order": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsLBSSLPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	elbconn := meta.(*AWSClient).elbconn
+
+	var policyNames []*string
+	if v, ok := d.GetOk("names"); ok {
+		policyNames = expandStringList(v.([]interface{}))
+	}
+
+	descs, err := describeLBSSLPolicies(elbconn, policyNames)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(descs))
+	policies := make([]map[string]interface{}, 0, len(descs))
+	for _, p := range descs {
+		names = append(names, aws.StringValue(p.PolicyName))
+		policies = append(policies, flattenLBSSLPolicy(p))
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+	d.Set("names", names)
+	if err := d.Set("policies", policies); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// describeLBSSLPolicies returns the predefined SSL negotiation policies,
+// sorted by name. All of them are returned when no name is given.
+func describeLBSSLPolicies(conn *elb.ELB, names []*string) ([]*elb.PolicyDescription, error) {
+	// Without a load balancer name, the API describes the predefined policies.
+	req := &elb.DescribeLoadBalancerPoliciesInput{
+		PolicyNames: names,
+	}
+
+	log.Printf("[DEBUG] Reading predefined LB SSL policies: %s", req)
+	resp, err := conn.DescribeLoadBalancerPolicies(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving predefined LB SSL policies: %s", err)
+	}
+
+	var descs []*elb.PolicyDescription
+	for _, p := range resp.PolicyDescriptions {
+		if aws.StringValue(p.PolicyTypeName) == "SSLNegotiationPolicyType" {
+			descs = append(descs, p)
+		}
+	}
+	sort.Slice(descs, func(i, j int) bool {
+		return aws.StringValue(descs[i].PolicyName) < aws.StringValue(descs[j].PolicyName)
+	})
+
+	return descs, nil
+}
+
+// flattenLBSSLPolicy splits the attributes of an SSL negotiation policy into
+// the enabled protocols and ciphers.
+func flattenLBSSLPolicy(p *elb.PolicyDescription) map[string]interface{} {
+	protocols := make([]string, 0)
+	ciphers := make([]string, 0)
+	var serverOrder bool
+
+	for _, attr := range p.PolicyAttributeDescriptions {
+		name := aws.StringValue(attr.AttributeName)
+		enabled := aws.StringValue(attr.AttributeValue) == "true"
+
+		switch {
+		case name == "Reference-Security-Policy":
+			continue
+		case name == "Server-Defined-Cipher-Order":
+			serverOrder = enabled
+		case strings.HasPrefix(name, "Protocol-"):
+			if enabled {
+				protocols = append(protocols, strings.TrimPrefix(name, "Protocol-"))
+			}
+		default:
+			if enabled {
+				ciphers = append(ciphers, name)
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"name":                        aws.StringValue(p.PolicyName),
+		"protocols":                   protocols,
+		"ciphers":                     ciphers,
+		"server_defined_cipher_order": serverOrder,
+	}
+}
@@ -0,0 +1,70 @@
+package osc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAWSLBSSLPolicies_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSLBSSLPoliciesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aws_lb_ssl_policies.all", "names.#"),
+					resource.TestCheckResourceAttr("data.aws_lb_ssl_policies.one", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_lb_ssl_policies.one", "policies.0.name", "ELBSecurityPolicy-2016-08"),
+					resource.TestCheckResourceAttr("data.aws_lb_ssl_policies.one", "policies.0.server_defined_cipher_order", "true"),
+					resource.TestCheckResourceAttrSet("data.aws_lb_ssl_policies.one", "policies.0.ciphers.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenLBSSLPolicy(t *testing.T) {
+	attr := func(name, value string) *elb.PolicyAttributeDescription {
+		return &elb.PolicyAttributeDescription{
+			AttributeName:  aws.String(name),
+			AttributeValue: aws.String(value),
+		}
+	}
+
+	p := &elb.PolicyDescription{
+		PolicyName:     aws.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+		PolicyTypeName: aws.String("SSLNegotiationPolicyType"),
+		PolicyAttributeDescriptions: []*elb.PolicyAttributeDescription{
+			attr("Reference-Security-Policy", "ELBSecurityPolicy-TLS-1-2-2017-01"),
+			attr("Protocol-TLSv1", "false"),
+			attr("Protocol-TLSv1.2", "true"),
+			attr("Server-Defined-Cipher-Order", "true"),
+			attr("ECDHE-RSA-AES128-GCM-SHA256", "true"),
+			attr("RC4-SHA", "false"),
+		},
+	}
+
+	expected := map[string]interface{}{
+		"name":                        "ELBSecurityPolicy-TLS-1-2-2017-01",
+		"protocols":                   []string{"TLSv1.2"},
+		"ciphers":                     []string{"ECDHE-RSA-AES128-GCM-SHA256"},
+		"server_defined_cipher_order": true,
+	}
+
+	if got := flattenLBSSLPolicy(p); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", got, expected)
+	}
+}
+
+const testAccDataSourceAWSLBSSLPoliciesConfig = `
+data "aws_lb_ssl_policies" "all" {}
+
+data "aws_lb_ssl_policies" "one" {
+  names = ["ELBSecurityPolicy-2016-08"]
+}
+`
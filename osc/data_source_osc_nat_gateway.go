@@ -0,0 +1,126 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsNatGateway() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNatGatewayRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"tags":   tagsSchemaComputed(),
+			"allocation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	req := &ec2.DescribeNatGatewaysInput{}
+
+	if id, ok := d.GetOk("id"); ok {
+		req.NatGatewayIds = []*string{aws.String(id.(string))}
+	}
+
+	req.Filter = buildEC2AttributeFilterList(
+		map[string]string{
+			"state":     d.Get("state").(string),
+			"vpc-id":    d.Get("vpc_id").(string),
+			"subnet-id": d.Get("subnet_id").(string),
+		},
+	)
+	req.Filter = append(req.Filter, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filter = append(req.Filter, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filter) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filter = nil
+	}
+
+	log.Printf("[DEBUG] Describe NAT Gateways %v\n", req)
+	resp, err := conn.DescribeNatGateways(req)
+	if err != nil {
+		return err
+	}
+
+	// Deleted gateways are listed for a while after their deletion, they are
+	// only returned when asked for by state.
+	var gateways []*ec2.NatGateway
+	for _, ng := range resp.NatGateways {
+		if _, ok := d.GetOk("state"); !ok && aws.StringValue(ng.State) == ec2.NatGatewayStateDeleted {
+			continue
+		}
+		gateways = append(gateways, ng)
+	}
+
+	if len(gateways) == 0 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+	if len(gateways) > 1 {
+		return fmt.Errorf("Multiple NAT Gateways matched; use additional constraints to reduce matches to a single NAT Gateway")
+	}
+
+	ng := gateways[0]
+
+	d.SetId(aws.StringValue(ng.NatGatewayId))
+	d.Set("id", ng.NatGatewayId)
+	d.Set("state", ng.State)
+	d.Set("vpc_id", ng.VpcId)
+	d.Set("subnet_id", ng.SubnetId)
+	d.Set("tags", tagsToMap(ng.Tags))
+
+	if len(ng.NatGatewayAddresses) > 0 {
+		address := ng.NatGatewayAddresses[0]
+		d.Set("allocation_id", address.AllocationId)
+		d.Set("network_interface_id", address.NetworkInterfaceId)
+		d.Set("private_ip", address.PrivateIp)
+		d.Set("public_ip", address.PublicIp)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsNatGateway_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsNatGatewayConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aws_nat_gateway.by_id", "id",
+						"aws_nat_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_nat_gateway.by_id", "public_ip",
+						"aws_eip.test", "public_ip"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_nat_gateway.by_id", "allocation_id",
+						"aws_eip.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.aws_nat_gateway.by_id", "state", "available"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_nat_gateway.by_subnet", "id",
+						"aws_nat_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_nat_gateway.by_subnet", "vpc_id",
+						"aws_vpc.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsNatGatewayConfig = `
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+  cidr_block = "172.16.10.0/24"
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_eip" "test" {
+  vpc = true
+}
+
+resource "aws_nat_gateway" "test" {
+  allocation_id = "${aws_eip.test.id}"
+  subnet_id = "${aws_subnet.test.id}"
+
+  depends_on = ["aws_internet_gateway.test"]
+}
+
+data "aws_nat_gateway" "by_id" {
+  id = "${aws_nat_gateway.test.id}"
+}
+
+data "aws_nat_gateway" "by_subnet" {
+  subnet_id = "${aws_nat_gateway.test.subnet_id}"
+}
+`
@@ -0,0 +1,147 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNetworkInterfaceRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"tags":   tagsSchemaComputed(),
+			"private_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_dest_check": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"attachment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"attachment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	req := &ec2.DescribeNetworkInterfacesInput{}
+
+	if id, ok := d.GetOk("id"); ok {
+		req.NetworkInterfaceIds = []*string{aws.String(id.(string))}
+	}
+
+	req.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"subnet-id":          d.Get("subnet_id").(string),
+			"vpc-id":             d.Get("vpc_id").(string),
+			"private-ip-address": d.Get("private_ip").(string),
+		},
+	)
+	req.Filters = append(req.Filters, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Describe Network Interfaces %v\n", req)
+	resp, err := conn.DescribeNetworkInterfaces(req)
+	if err != nil {
+		return err
+	}
+	if resp == nil || len(resp.NetworkInterfaces) == 0 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+	if len(resp.NetworkInterfaces) > 1 {
+		return fmt.Errorf("Multiple Network Interfaces matched; use additional constraints to reduce matches to a single Network Interface")
+	}
+
+	eni := resp.NetworkInterfaces[0]
+
+	d.SetId(aws.StringValue(eni.NetworkInterfaceId))
+	d.Set("id", eni.NetworkInterfaceId)
+	d.Set("subnet_id", eni.SubnetId)
+	d.Set("vpc_id", eni.VpcId)
+	d.Set("private_ip", eni.PrivateIpAddress)
+	d.Set("private_ips", flattenNetworkInterfacesPrivateIPAddresses(eni.PrivateIpAddresses))
+	d.Set("security_groups", flattenGroupIdentifiers(eni.Groups))
+	d.Set("description", eni.Description)
+	d.Set("mac_address", eni.MacAddress)
+	d.Set("source_dest_check", eni.SourceDestCheck)
+	d.Set("tags", tagsToMap(eni.TagSet))
+
+	if eni.Attachment != nil {
+		attachment := []map[string]interface{}{flattenAttachment(eni.Attachment)}
+		if err := d.Set("attachment", attachment); err != nil {
+			return err
+		}
+	} else {
+		d.Set("attachment", nil)
+	}
+
+	return nil
+}
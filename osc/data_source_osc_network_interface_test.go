@@ -0,0 +1,81 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsNetworkInterface_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsNetworkInterfaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aws_network_interface.by_id", "id",
+						"aws_network_interface.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_network_interface.by_id", "private_ip",
+						"aws_network_interface.test", "private_ip"),
+					resource.TestCheckResourceAttr(
+						"data.aws_network_interface.by_id", "security_groups.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_network_interface.by_tag", "id",
+						"aws_network_interface.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_network_interface.by_filter", "subnet_id",
+						"aws_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_network_interface.by_filter", "vpc_id",
+						"aws_vpc.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsNetworkInterfaceConfig = `
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+  cidr_block = "172.16.10.0/24"
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+  name = "terraform-testacc-eni-data-source"
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = "${aws_subnet.test.id}"
+  private_ips = ["172.16.10.100"]
+  security_groups = ["${aws_security_group.test.id}"]
+
+  tags {
+    Name = "terraform-testacc-eni-data-source"
+  }
+}
+
+data "aws_network_interface" "by_id" {
+  id = "${aws_network_interface.test.id}"
+}
+
+data "aws_network_interface" "by_tag" {
+  tags {
+    Name = "${aws_network_interface.test.tags["Name"]}"
+  }
+}
+
+data "aws_network_interface" "by_filter" {
+  filter {
+    name = "private-ip-address"
+    values = ["${aws_network_interface.test.private_ip}"]
+  }
+}
+`
@@ -0,0 +1,121 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsSecurityGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSecurityGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"filter": ec2CustomFiltersSchema(),
+
+			"tags": tagsSchemaComputed(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"security_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeSecurityGroupsInput{}
+	req.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"vpc-id": d.Get("vpc_id").(string),
+		},
+	)
+	req.Filters = append(req.Filters, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Describe Security Groups %v\n", req)
+	var groups []*ec2.SecurityGroup
+	err := conn.DescribeSecurityGroupsPages(req, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		groups = append(groups, page.SecurityGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Security Groups: %s", err)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return aws.StringValue(groups[i].GroupId) < aws.StringValue(groups[j].GroupId)
+	})
+
+	ids := make([]string, 0, len(groups))
+	items := make([]map[string]interface{}, 0, len(groups))
+	for _, sg := range groups {
+		ids = append(ids, aws.StringValue(sg.GroupId))
+		items = append(items, map[string]interface{}{
+			"id":          aws.StringValue(sg.GroupId),
+			"name":        aws.StringValue(sg.GroupName),
+			"description": aws.StringValue(sg.Description),
+			"vpc_id":      aws.StringValue(sg.VpcId),
+			"tags":        tagsToMap(sg.Tags),
+		})
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting Security Group ids: %s", err)
+	}
+	if err := d.Set("security_groups", items); err != nil {
+		return fmt.Errorf("Error setting Security Groups: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsSecurityGroups_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsSecurityGroupsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_security_groups.by_tag", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_security_groups.by_tag", "security_groups.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_security_groups.by_tag", "security_groups.0.vpc_id",
+						"aws_vpc.test", "id"),
+					// The default group of the VPC comes on top of the two
+					// created ones.
+					resource.TestCheckResourceAttr("data.aws_security_groups.by_vpc", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.aws_security_groups.by_filter", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_security_groups.by_filter", "ids.0",
+						"aws_security_group.db_a", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsSecurityGroupsConfig = `
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "aws_security_group" "db_a" {
+  vpc_id = "${aws_vpc.test.id}"
+  name = "terraform-testacc-security-groups-a"
+
+  tags {
+    role = "db"
+  }
+}
+
+resource "aws_security_group" "db_b" {
+  vpc_id = "${aws_vpc.test.id}"
+  name = "terraform-testacc-security-groups-b"
+
+  tags {
+    role = "db"
+  }
+}
+
+data "aws_security_groups" "by_tag" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    role = "db"
+  }
+
+  depends_on = ["aws_security_group.db_a", "aws_security_group.db_b"]
+}
+
+data "aws_security_groups" "by_vpc" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  depends_on = ["aws_security_group.db_a", "aws_security_group.db_b"]
+}
+
+data "aws_security_groups" "by_filter" {
+  filter {
+    name = "group-name"
+    values = ["${aws_security_group.db_a.name}"]
+  }
+}
+`
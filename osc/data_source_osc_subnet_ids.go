@@ -0,0 +1,118 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsSubnetIDs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSubnetIDsRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"filter": ec2CustomFiltersSchema(),
+
+			"tags": tagsSchemaComputed(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"map_public_ip_on_launch": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsSubnetIDsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	vpcId := d.Get("vpc_id").(string)
+
+	req := &ec2.DescribeSubnetsInput{}
+	req.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"vpc-id": vpcId,
+		},
+	)
+	req.Filters = append(req.Filters, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	log.Printf("[DEBUG] DescribeSubnets %s\n", req)
+	var subnets []*ec2.Subnet
+	err := conn.DescribeSubnetsPages(req, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		subnets = append(subnets, page.Subnets...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading subnets of VPC %s: %s", vpcId, err)
+	}
+
+	sort.Slice(subnets, func(i, j int) bool {
+		return aws.StringValue(subnets[i].SubnetId) < aws.StringValue(subnets[j].SubnetId)
+	})
+
+	ids := make([]string, 0, len(subnets))
+	items := make([]map[string]interface{}, 0, len(subnets))
+	for _, subnet := range subnets {
+		ids = append(ids, aws.StringValue(subnet.SubnetId))
+		items = append(items, map[string]interface{}{
+			"id":                      aws.StringValue(subnet.SubnetId),
+			"cidr_block":              aws.StringValue(subnet.CidrBlock),
+			"availability_zone":       aws.StringValue(subnet.AvailabilityZone),
+			"map_public_ip_on_launch": aws.BoolValue(subnet.MapPublicIpOnLaunch),
+			"tags":                    tagsToMap(subnet.Tags),
+		})
+	}
+
+	d.SetId(vpcId)
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting subnet ids: %s", err)
+	}
+	if err := d.Set("subnets", items); err != nil {
+		return fmt.Errorf("Error setting subnets: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsSubnetIDs_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsSubnetIDsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_subnet_ids.all", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.aws_subnet_ids.private", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_subnet_ids.private", "subnets.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.aws_subnet_ids.private", "subnets.0.tags.Tier", "private"),
+					resource.TestCheckResourceAttr("data.aws_subnet_ids.by_filter", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_subnet_ids.by_filter", "ids.0",
+						"aws_subnet.public", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsSubnetIDsConfig = `
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "aws_subnet" "public" {
+  vpc_id = "${aws_vpc.test.id}"
+  cidr_block = "172.16.1.0/24"
+
+  tags {
+    Tier = "public"
+  }
+}
+
+resource "aws_subnet" "private_a" {
+  vpc_id = "${aws_vpc.test.id}"
+  cidr_block = "172.16.2.0/24"
+
+  tags {
+    Tier = "private"
+  }
+}
+
+resource "aws_subnet" "private_b" {
+  vpc_id = "${aws_vpc.test.id}"
+  cidr_block = "172.16.3.0/24"
+
+  tags {
+    Tier = "private"
+  }
+}
+
+data "aws_subnet_ids" "all" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  depends_on = ["aws_subnet.public", "aws_subnet.private_a", "aws_subnet.private_b"]
+}
+
+data "aws_subnet_ids" "private" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Tier = "private"
+  }
+
+  depends_on = ["aws_subnet.private_a", "aws_subnet.private_b"]
+}
+
+data "aws_subnet_ids" "by_filter" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  filter {
+    name = "cidr-block"
+    values = ["${aws_subnet.public.cidr_block}"]
+  }
+}
+`
@@ -0,0 +1,111 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsVpcs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsVpcsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"tags": tagsSchemaComputed(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"vpcs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsVpcsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeVpcsInput{}
+	req.Filters = buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] DescribeVpcs %s\n", req)
+	var vpcs []*ec2.Vpc
+	err := conn.DescribeVpcsPages(req, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		vpcs = append(vpcs, page.Vpcs...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading VPCs: %s", err)
+	}
+
+	sort.Slice(vpcs, func(i, j int) bool {
+		return aws.StringValue(vpcs[i].VpcId) < aws.StringValue(vpcs[j].VpcId)
+	})
+
+	ids := make([]string, 0, len(vpcs))
+	items := make([]map[string]interface{}, 0, len(vpcs))
+	for _, vpc := range vpcs {
+		ids = append(ids, aws.StringValue(vpc.VpcId))
+		items = append(items, map[string]interface{}{
+			"id":         aws.StringValue(vpc.VpcId),
+			"cidr_block": aws.StringValue(vpc.CidrBlock),
+			"default":    aws.BoolValue(vpc.IsDefault),
+			"state":      aws.StringValue(vpc.State),
+			"tags":       tagsToMap(vpc.Tags),
+		})
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting VPC ids: %s", err)
+	}
+	if err := d.Set("vpcs", items); err != nil {
+		return fmt.Errorf("Error setting VPCs: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsVpcs_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsVpcsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_vpcs.by_tag", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_vpcs.by_tag", "vpcs.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpcs.by_tag", "vpcs.0.tags.Role", "terraform-testacc-vpcs-data-source"),
+					resource.TestCheckResourceAttr("data.aws_vpcs.by_filter", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpcs.by_filter", "ids.0",
+						"aws_vpc.a", "id"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpcs.by_filter", "vpcs.0.cidr_block", "172.16.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsVpcsConfig = `
+resource "aws_vpc" "a" {
+  cidr_block = "172.16.0.0/16"
+
+  tags {
+    Role = "terraform-testacc-vpcs-data-source"
+  }
+}
+
+resource "aws_vpc" "b" {
+  cidr_block = "172.17.0.0/16"
+
+  tags {
+    Role = "terraform-testacc-vpcs-data-source"
+  }
+}
+
+data "aws_vpcs" "by_tag" {
+  tags {
+    Role = "${aws_vpc.a.tags["Role"]}"
+  }
+
+  depends_on = ["aws_vpc.b"]
+}
+
+data "aws_vpcs" "by_filter" {
+  filter {
+    name = "cidr"
+    values = ["${aws_vpc.a.cidr_block}"]
+  }
+
+  tags {
+    Role = "${aws_vpc.a.tags["Role"]}"
+  }
+}
+`
@@ -0,0 +1,186 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsVpnConnection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsVpnConnectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpn_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"customer_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"tags":   tagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"static_routes_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"customer_gateway_configuration": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tunnel": vpnConnectionTunnelSchema(),
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vgw_telemetry": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accepted_route_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_status_change": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outside_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	req := &ec2.DescribeVpnConnectionsInput{}
+
+	if id, ok := d.GetOk("id"); ok {
+		req.VpnConnectionIds = []*string{aws.String(id.(string))}
+	}
+
+	req.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"vpn-gateway-id":      d.Get("vpn_gateway_id").(string),
+			"customer-gateway-id": d.Get("customer_gateway_id").(string),
+			"state":               d.Get("state").(string),
+		},
+	)
+	req.Filters = append(req.Filters, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Describe VPN Connections %v\n", req)
+	resp, err := conn.DescribeVpnConnections(req)
+	if err != nil {
+		return err
+	}
+
+	// Deleted connections are listed for a while after their deletion, they
+	// are only returned when asked for by state.
+	var connections []*ec2.VpnConnection
+	for _, vpnConnection := range resp.VpnConnections {
+		if _, ok := d.GetOk("state"); !ok && aws.StringValue(vpnConnection.State) == ec2.VpnStateDeleted {
+			continue
+		}
+		connections = append(connections, vpnConnection)
+	}
+
+	if len(connections) == 0 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+	if len(connections) > 1 {
+		return fmt.Errorf("Multiple VPN Connections matched; use additional constraints to reduce matches to a single VPN Connection")
+	}
+
+	vpnConnection := connections[0]
+
+	d.SetId(aws.StringValue(vpnConnection.VpnConnectionId))
+	d.Set("id", vpnConnection.VpnConnectionId)
+	d.Set("vpn_gateway_id", vpnConnection.VpnGatewayId)
+	d.Set("customer_gateway_id", vpnConnection.CustomerGatewayId)
+	d.Set("state", vpnConnection.State)
+	d.Set("type", vpnConnection.Type)
+	d.Set("tags", tagsToMap(vpnConnection.Tags))
+	d.Set("static_routes_only", vpnConnection.Options != nil && aws.BoolValue(vpnConnection.Options.StaticRoutesOnly))
+	d.Set("customer_gateway_configuration", vpnConnection.CustomerGatewayConfiguration)
+
+	tunnels := []map[string]interface{}{}
+	if vpnConnection.CustomerGatewayConfiguration != nil {
+		vpnConfig, err := xmlConfigToVpnConnectionConfig(*vpnConnection.CustomerGatewayConfiguration)
+		if err != nil {
+			return fmt.Errorf("Error parsing customer gateway configuration of VPN connection %s: %s", d.Id(), err)
+		}
+		tunnels = flattenVpnConnectionTunnels(vpnConfig.Tunnels)
+	}
+	if err := d.Set("tunnel", tunnels); err != nil {
+		return err
+	}
+
+	if err := d.Set("vgw_telemetry", telemetryToMapList(vpnConnection.VgwTelemetry)); err != nil {
+		return err
+	}
+	if err := d.Set("routes", routesToMapList(vpnConnection.Routes)); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsVpnConnectionDeviceConfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsVpnConnectionDeviceConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"device_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateVpnDeviceType,
+			},
+			// Routed through the tunnels when the connection uses static
+			// routes instead of BGP.
+			"vpc_cidr_blocks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDRNetworkAddress,
+				},
+			},
+			"static_routes_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tunnel": vpnConnectionTunnelSchema(),
+		},
+	}
+}
+
+func dataSourceAwsVpnConnectionDeviceConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	vpnConnectionId := d.Get("vpn_connection_id").(string)
+	log.Printf("[DEBUG] Reading VPN connection %s", vpnConnectionId)
+	resp, err := conn.DescribeVpnConnections(&ec2.DescribeVpnConnectionsInput{
+		VpnConnectionIds: []*string{aws.String(vpnConnectionId)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading VPN connection %s: %s", vpnConnectionId, err)
+	}
+	if len(resp.VpnConnections) != 1 {
+		return fmt.Errorf("VPN connection %s not found", vpnConnectionId)
+	}
+
+	vpnConnection := resp.VpnConnections[0]
+	if vpnConnection.CustomerGatewayConfiguration == nil {
+		return fmt.Errorf("VPN connection %s has no customer gateway configuration (state: %s)",
+			vpnConnectionId, aws.StringValue(vpnConnection.State))
+	}
+
+	vpnConfig, err := xmlConfigToVpnConnectionConfig(*vpnConnection.CustomerGatewayConfiguration)
+	if err != nil {
+		return fmt.Errorf("Error parsing customer gateway configuration of VPN connection %s: %s", vpnConnectionId, err)
+	}
+
+	staticRoutesOnly := vpnConnection.Options != nil && aws.BoolValue(vpnConnection.Options.StaticRoutesOnly)
+
+	deviceType := d.Get("device_type").(string)
+	config, err := renderVpnDeviceConfig(deviceType, &vpnDeviceConfigData{
+		VpnConnectionId:  vpnConnectionId,
+		StaticRoutesOnly: staticRoutesOnly,
+		VpcCidrBlocks:    aws.StringValueSlice(expandStringList(d.Get("vpc_cidr_blocks").([]interface{}))),
+		Tunnels:          vpnConfig.Tunnels,
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s", vpnConnectionId, deviceType))
+	d.Set("static_routes_only", staticRoutesOnly)
+	d.Set("config", config)
+	if err := d.Set("tunnel", flattenVpnConnectionTunnels(vpnConfig.Tunnels)); err != nil {
+		return err
+	}
+
+	return nil
+}
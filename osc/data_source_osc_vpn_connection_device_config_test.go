@@ -0,0 +1,67 @@
+package osc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsVpnConnectionDeviceConfig_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsVpnConnectionDeviceConfigConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.aws_vpn_connection_device_config.vyos", "tunnel.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpn_connection_device_config.vyos", "static_routes_only", "true"),
+					resource.TestMatchResourceAttr(
+						"data.aws_vpn_connection_device_config.vyos", "config",
+						regexp.MustCompile("set protocols static interface-route 10.0.0.0/16 next-hop-interface vti1")),
+					resource.TestMatchResourceAttr(
+						"data.aws_vpn_connection_device_config.strongswan", "config",
+						regexp.MustCompile("conn vpn-[0-9a-f]+-1")),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection_device_config.strongswan", "tunnel.0.outside_address",
+						"aws_vpn_connection.foo", "tunnel1_address"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsVpnConnectionDeviceConfigConfig = `
+resource "aws_vpn_gateway" "vpn_gateway" {
+  tags {
+    Name = "vpn_gateway"
+  }
+}
+
+resource "aws_customer_gateway" "customer_gateway" {
+  bgp_asn = 65000
+  ip_address = "178.0.0.1"
+  type = "ipsec.1"
+}
+
+resource "aws_vpn_connection" "foo" {
+  vpn_gateway_id = "${aws_vpn_gateway.vpn_gateway.id}"
+  customer_gateway_id = "${aws_customer_gateway.customer_gateway.id}"
+  type = "ipsec.1"
+  static_routes_only = true
+}
+
+data "aws_vpn_connection_device_config" "vyos" {
+  vpn_connection_id = "${aws_vpn_connection.foo.id}"
+  device_type = "vyos"
+  vpc_cidr_blocks = ["10.0.0.0/16"]
+}
+
+data "aws_vpn_connection_device_config" "strongswan" {
+  vpn_connection_id = "${aws_vpn_connection.foo.id}"
+  device_type = "strongswan"
+}
+`
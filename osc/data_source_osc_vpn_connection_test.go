@@ -0,0 +1,64 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsVpnConnection_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsVpnConnectionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection.by_id", "id",
+						"aws_vpn_connection.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpn_connection.by_id", "static_routes_only", "true"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpn_connection.by_id", "tunnel.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection.by_id", "tunnel.0.outside_address",
+						"aws_vpn_connection.test", "tunnel1_address"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection.by_gateways", "id",
+						"aws_vpn_connection.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsVpnConnectionConfig = `
+resource "aws_vpn_gateway" "test" {
+  tags {
+    Name = "terraform-testacc-vpn-connection-data-source"
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn = 65000
+  ip_address = "178.0.0.13"
+  type = "ipsec.1"
+}
+
+resource "aws_vpn_connection" "test" {
+  vpn_gateway_id = "${aws_vpn_gateway.test.id}"
+  customer_gateway_id = "${aws_customer_gateway.test.id}"
+  type = "ipsec.1"
+  static_routes_only = true
+}
+
+data "aws_vpn_connection" "by_id" {
+  id = "${aws_vpn_connection.test.id}"
+}
+
+data "aws_vpn_connection" "by_gateways" {
+  vpn_gateway_id = "${aws_vpn_connection.test.vpn_gateway_id}"
+  customer_gateway_id = "${aws_vpn_connection.test.customer_gateway_id}"
+}
+`
@@ -0,0 +1,63 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsVpnGateway_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsVpnGatewayConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_gateway.by_id", "id",
+						"aws_vpn_gateway.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpn_gateway.by_id", "state", "available"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_gateway.by_id", "attached_vpc_id",
+						"aws_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_gateway.by_vpc_id", "id",
+						"aws_vpn_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_gateway.by_tag", "id",
+						"aws_vpn_gateway.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsVpnGatewayConfig = `
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "aws_vpn_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "terraform-testacc-vgw-data-source"
+  }
+}
+
+data "aws_vpn_gateway" "by_id" {
+  id = "${aws_vpn_gateway.test.id}"
+}
+
+data "aws_vpn_gateway" "by_vpc_id" {
+  attached_vpc_id = "${aws_vpn_gateway.test.vpc_id}"
+}
+
+data "aws_vpn_gateway" "by_tag" {
+  tags {
+    Name = "${aws_vpn_gateway.test.tags["Name"]}"
+  }
+}
+`
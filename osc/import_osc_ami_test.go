@@ -0,0 +1,95 @@
+package osc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAMI_importBasic(t *testing.T) {
+	resourceName := "aws_ami.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAmiConfig_basic(rInt),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAMICopy_importBasic(t *testing.T) {
+	resourceName := "aws_ami_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAMICopyConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAMIImportStateIdFunc(resourceName, "source_ami_id", "source_ami_region"),
+				ImportStateVerify: true,
+				// Only set on create
+				ImportStateVerifyIgnore: []string{"encrypted"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAMIFromInstance_importBasic(t *testing.T) {
+	resourceName := "aws_ami_from_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAMIFromInstanceConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAMIImportStateIdFunc(resourceName, "source_instance_id"),
+				ImportStateVerify: true,
+				// Only set on create
+				ImportStateVerifyIgnore: []string{"snapshot_without_reboot"},
+			},
+		},
+	})
+}
+
+func testAccAWSAMIImportStateIdFunc(resourceName string, attrs ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id := rs.Primary.ID
+		for _, attr := range attrs {
+			id = fmt.Sprintf("%s:%s", id, rs.Primary.Attributes[attr])
+		}
+		return id, nil
+	}
+}
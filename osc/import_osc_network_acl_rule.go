@@ -0,0 +1,63 @@
+package osc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Network ACL rules are imported with an ID made of the network ACL, the rule
+// number and the direction of the rule, e.g. acl-12345678:100:ingress.
+func resourceAwsNetworkAclRuleImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	networkAclId, ruleNumber, egress, err := parseNetworkAclRuleImportId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("network_acl_id", networkAclId)
+	d.Set("rule_number", ruleNumber)
+	d.Set("egress", egress)
+
+	entry, err := findNetworkAclRule(d, meta)
+	if err != nil {
+		return nil, fmt.Errorf("Error importing Network ACL rule %q: %s", d.Id(), err)
+	}
+
+	protocol := *entry.Protocol
+	if p, err := strconv.Atoi(protocol); err == nil {
+		if name, ok := protocolStrings(protocolIntegers())[p]; ok {
+			protocol = name
+		}
+	}
+	d.Set("protocol", protocol)
+	d.SetId(networkAclIdRuleNumberEgressHash(networkAclId, ruleNumber, egress, protocol))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseNetworkAclRuleImportId(id string) (string, int, bool, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], "acl-") {
+		return "", 0, false, fmt.Errorf("Unexpected format of ID (%q), expected NETWORKACLID:RULENUMBER:DIRECTION", id)
+	}
+
+	ruleNumber, err := strconv.Atoi(parts[1])
+	if err != nil || ruleNumber < 1 || ruleNumber > 32766 {
+		return "", 0, false, fmt.Errorf("Unexpected format of ID (%q), rule number must be between 1 and 32766, got %q", id, parts[1])
+	}
+
+	var egress bool
+	switch parts[2] {
+	case "ingress":
+	case "egress":
+		egress = true
+	default:
+		return "", 0, false, fmt.Errorf("Unexpected format of ID (%q), direction must be ingress or egress, got %q", id, parts[2])
+	}
+
+	return parts[0], ruleNumber, egress, nil
+}
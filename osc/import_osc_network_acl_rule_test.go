@@ -0,0 +1,60 @@
+package osc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseNetworkAclRuleImportId(t *testing.T) {
+	networkAclId, ruleNumber, egress, err := parseNetworkAclRuleImportId("acl-12345678:100:egress")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if networkAclId != "acl-12345678" || ruleNumber != 100 || !egress {
+		t.Fatalf("Bad rule: %s, %d, %t", networkAclId, ruleNumber, egress)
+	}
+
+	for _, id := range []string{
+		"acl-12345678:100",
+		"acl-12345678:100:outbound",
+		"acl-12345678:rule:ingress",
+		"acl-12345678:32767:ingress",
+		"rtb-12345678:100:ingress",
+	} {
+		if _, _, _, err := parseNetworkAclRuleImportId(id); err == nil {
+			t.Fatalf("%s: expected an error", id)
+		}
+	}
+}
+
+func TestAccAWSNetworkAclRule_importBasic(t *testing.T) {
+	resourceName := "aws_network_acl_rule.baz"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkAclRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSNetworkAclRuleBasicConfig,
+			},
+
+			resource.TestStep{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s:%s:ingress", rs.Primary.Attributes["network_acl_id"],
+						rs.Primary.Attributes["rule_number"]), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,45 @@
+package osc
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Routes are imported with an ID made of the route table and the destination
+// CIDR block joined by an underscore, e.g. rtb-12345678_10.1.0.0/16.
+func resourceAwsRouteImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	routeTableId, cidr, err := parseRouteImportId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := findResourceRoute(conn, routeTableId, cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("route_table_id", routeTableId)
+	d.Set("destination_cidr_block", cidr)
+	d.SetId(routeIDHash(d, route))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseRouteImportId(id string) (string, string, error) {
+	parts := strings.Split(id, "_")
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "rtb-") {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected ROUTETABLEID_DESTINATION", id)
+	}
+	if _, _, err := net.ParseCIDR(parts[1]); err != nil {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), %q is not a CIDR block", id, parts[1])
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -26,6 +26,10 @@ func resourceAwsRouteTableImportState(
 	}
 	table := resp.RouteTables[0]
 
+	// propagating_vgws is left empty: the propagations can't be told apart
+	// from the ones managed by aws_vpn_gateway_route_propagation, so the
+	// route table doesn't take ownership of any of them.
+
 	// Start building our results
 	results := make([]*schema.ResourceData, 1,
 		2+len(table.Associations)+len(table.Routes))
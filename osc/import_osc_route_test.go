@@ -0,0 +1,59 @@
+package osc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseRouteImportId(t *testing.T) {
+	routeTableId, cidr, err := parseRouteImportId("rtb-12345678_10.1.0.0/16")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if routeTableId != "rtb-12345678" || cidr != "10.1.0.0/16" {
+		t.Fatalf("Bad route: %s, %s", routeTableId, cidr)
+	}
+
+	for _, id := range []string{
+		"rtb-12345678",
+		"rtb-12345678_10.1.0.0",
+		"rtb-12345678_10.1.0.0/16_10.2.0.0/16",
+		"vpc-12345678_10.1.0.0/16",
+	} {
+		if _, _, err := parseRouteImportId(id); err == nil {
+			t.Fatalf("%s: expected an error", id)
+		}
+	}
+}
+
+func TestAccAWSRoute_importBasic(t *testing.T) {
+	resourceName := "aws_route.bar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSRouteBasicConfig,
+			},
+
+			resource.TestStep{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s_%s", rs.Primary.Attributes["route_table_id"],
+						rs.Primary.Attributes["destination_cidr_block"]), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
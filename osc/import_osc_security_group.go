@@ -30,6 +30,7 @@ func resourceAwsSecurityGroupImportState(
 	results := make([]*schema.ResourceData, 1,
 		1+len(sg.IpPermissions)+len(sg.IpPermissionsEgress))
 	results[0] = d
+	d.Set("revoke_rules_on_delete", false)
 
 	// Construct the rules
 	permMap := map[string][]*ec2.IpPermission{
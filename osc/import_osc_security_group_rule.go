@@ -0,0 +1,144 @@
+package osc
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Security group rules are imported with an ID made of the group, the rule
+// type, the protocol, the port range and the sources of the rule joined by
+// underscores, e.g. sg-12345678_ingress_tcp_443_443_10.0.0.0/8. A source is a
+// CIDR block, a prefix list, a security group or "self".
+func resourceAwsSecurityGroupRuleImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	rule, err := parseSecurityGroupRuleImportId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	sg, err := findResourceSecurityGroup(conn, rule.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("security_group_id", rule.GroupId)
+	d.Set("type", rule.RuleType)
+	d.Set("protocol", rule.Protocol)
+	d.Set("from_port", rule.FromPort)
+	d.Set("to_port", rule.ToPort)
+	d.Set("self", rule.Self)
+	d.Set("cidr_blocks", rule.CidrBlocks)
+	d.Set("prefix_list_ids", rule.PrefixListIds)
+	if rule.SourceSecurityGroupId != "" {
+		d.Set("source_security_group_id", rule.SourceSecurityGroupId)
+	}
+
+	perm, err := expandIPPerm(d, sg)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := sg.IpPermissions
+	if rule.RuleType == "egress" {
+		rules = sg.IpPermissionsEgress
+	}
+	isVPC := sg.VpcId != nil && *sg.VpcId != ""
+	if findRuleMatch(perm, rules, isVPC) == nil {
+		return nil, fmt.Errorf("No %s rule %s found in security group %s",
+			rule.RuleType, ipPermissionSummary(perm), rule.GroupId)
+	}
+
+	d.SetId(ipPermissionIDHash(rule.GroupId, rule.RuleType, perm))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type securityGroupRuleImportId struct {
+	GroupId               string
+	RuleType              string
+	Protocol              string
+	FromPort              int
+	ToPort                int
+	Self                  bool
+	CidrBlocks            []string
+	PrefixListIds         []string
+	SourceSecurityGroupId string
+}
+
+func parseSecurityGroupRuleImportId(id string) (*securityGroupRuleImportId, error) {
+	invalid := func(format string, a ...interface{}) error {
+		return fmt.Errorf("Unexpected format of ID (%q), expected "+
+			"SGID_TYPE_PROTOCOL_FROMPORT_TOPORT_SOURCE[_SOURCE...]: %s", id, fmt.Sprintf(format, a...))
+	}
+
+	parts := strings.Split(id, "_")
+	if len(parts) < 6 {
+		return nil, invalid("missing parts")
+	}
+
+	rule := &securityGroupRuleImportId{
+		GroupId:  parts[0],
+		RuleType: parts[1],
+		Protocol: protocolForValue(parts[2]),
+	}
+
+	if !strings.HasPrefix(rule.GroupId, "sg-") {
+		return nil, invalid("%q is not a security group ID", rule.GroupId)
+	}
+	if rule.RuleType != "ingress" && rule.RuleType != "egress" {
+		return nil, invalid("type must be ingress or egress, got %q", rule.RuleType)
+	}
+	if _, err := strconv.Atoi(rule.Protocol); err != nil {
+		if _, ok := sgProtocolIntegers()[rule.Protocol]; !ok {
+			return nil, invalid("unknown protocol %q", parts[2])
+		}
+	}
+
+	var err error
+	if rule.FromPort, err = strconv.Atoi(parts[3]); err != nil {
+		return nil, invalid("invalid from port %q", parts[3])
+	}
+	if rule.ToPort, err = strconv.Atoi(parts[4]); err != nil {
+		return nil, invalid("invalid to port %q", parts[4])
+	}
+
+	for _, source := range parts[5:] {
+		switch {
+		case source == "self":
+			if rule.Self {
+				return nil, invalid("self is given more than once")
+			}
+			rule.Self = true
+		case strings.HasPrefix(source, "sg-") || strings.Contains(source, "/sg-"):
+			// A rule resource only holds a single source security group,
+			// each of them has to be imported separately.
+			if rule.SourceSecurityGroupId != "" {
+				return nil, invalid("several source security groups are ambiguous, import each of them separately")
+			}
+			rule.SourceSecurityGroupId = source
+		case strings.HasPrefix(source, "pl-"):
+			rule.PrefixListIds = append(rule.PrefixListIds, source)
+		default:
+			if _, _, err := net.ParseCIDR(source); err != nil {
+				return nil, invalid("%q is neither a CIDR block, a prefix list, a security group nor self", source)
+			}
+			rule.CidrBlocks = append(rule.CidrBlocks, source)
+		}
+	}
+
+	if rule.SourceSecurityGroupId != "" && (rule.Self || len(rule.CidrBlocks) > 0) {
+		return nil, invalid("a source security group can't be combined with self or CIDR blocks")
+	}
+	if rule.Self && len(rule.CidrBlocks) > 0 {
+		return nil, invalid("self can't be combined with CIDR blocks")
+	}
+
+	return rule, nil
+}
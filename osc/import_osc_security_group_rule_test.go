@@ -0,0 +1,133 @@
+package osc
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseSecurityGroupRuleImportId(t *testing.T) {
+	cases := []struct {
+		Id       string
+		Expected *securityGroupRuleImportId
+		Err      bool
+	}{
+		{
+			Id: "sg-12345678_ingress_tcp_443_443_10.0.0.0/8",
+			Expected: &securityGroupRuleImportId{
+				GroupId:    "sg-12345678",
+				RuleType:   "ingress",
+				Protocol:   "tcp",
+				FromPort:   443,
+				ToPort:     443,
+				CidrBlocks: []string{"10.0.0.0/8"},
+			},
+		},
+		{
+			Id: "sg-12345678_egress_6_80_8000_10.0.0.0/8_192.168.0.0/16_pl-12345678",
+			Expected: &securityGroupRuleImportId{
+				GroupId:       "sg-12345678",
+				RuleType:      "egress",
+				Protocol:      "tcp",
+				FromPort:      80,
+				ToPort:        8000,
+				CidrBlocks:    []string{"10.0.0.0/8", "192.168.0.0/16"},
+				PrefixListIds: []string{"pl-12345678"},
+			},
+		},
+		{
+			Id: "sg-12345678_ingress_all_0_0_self",
+			Expected: &securityGroupRuleImportId{
+				GroupId:  "sg-12345678",
+				RuleType: "ingress",
+				Protocol: "-1",
+				Self:     true,
+			},
+		},
+		{
+			Id: "sg-12345678_ingress_icmp_-1_-1_123456789012/sg-87654321",
+			Expected: &securityGroupRuleImportId{
+				GroupId:               "sg-12345678",
+				RuleType:              "ingress",
+				Protocol:              "icmp",
+				FromPort:              -1,
+				ToPort:                -1,
+				SourceSecurityGroupId: "123456789012/sg-87654321",
+			},
+		},
+		// Missing source
+		{Id: "sg-12345678_ingress_tcp_443_443", Err: true},
+		// Not a security group
+		{Id: "vpc-12345678_ingress_tcp_443_443_10.0.0.0/8", Err: true},
+		// Bad type
+		{Id: "sg-12345678_inbound_tcp_443_443_10.0.0.0/8", Err: true},
+		// Bad protocol
+		{Id: "sg-12345678_ingress_foo_443_443_10.0.0.0/8", Err: true},
+		// Bad port
+		{Id: "sg-12345678_ingress_tcp_https_443_10.0.0.0/8", Err: true},
+		// Bad source
+		{Id: "sg-12345678_ingress_tcp_443_443_10.0.0.0", Err: true},
+		// Several source security groups
+		{Id: "sg-12345678_ingress_tcp_443_443_sg-1_sg-2", Err: true},
+		// Source security group with CIDR blocks
+		{Id: "sg-12345678_ingress_tcp_443_443_sg-1_10.0.0.0/8", Err: true},
+		// Self with CIDR blocks
+		{Id: "sg-12345678_ingress_tcp_443_443_self_10.0.0.0/8", Err: true},
+	}
+
+	for _, tc := range cases {
+		rule, err := parseSecurityGroupRuleImportId(tc.Id)
+		if tc.Err {
+			if err == nil {
+				t.Fatalf("%s: expected an error", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Id, err)
+		}
+		if !reflect.DeepEqual(rule, tc.Expected) {
+			t.Fatalf("%s: expected:\n%#v\ngot:\n%#v", tc.Id, tc.Expected, rule)
+		}
+	}
+}
+
+func TestAccAWSSecurityGroupRule_importBasic(t *testing.T) {
+	resourceName := "aws_security_group_rule.ingress_1"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSecurityGroupRuleIngressConfig(rInt),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSecurityGroupRuleImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityGroupRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		attrs := rs.Primary.Attributes
+		return fmt.Sprintf("%s_%s_%s_%s_%s_%s", attrs["security_group_id"], attrs["type"],
+			attrs["protocol"], attrs["from_port"], attrs["to_port"], attrs["cidr_blocks.0"]), nil
+	}
+}
@@ -0,0 +1,14 @@
+package osc
+
+// LBU delivers the access logs of load balancers to OOS with a service
+// account of its own in each region, which is not one of the AWS ELB
+// accounts of elbAccountIdPerRegionMap. A region is only listed here once
+// its account is published; the policies of the other regions can't be
+// built nor checked.
+var lbuServiceAccountIdPerRegionMap = map[string]string{}
+
+// Returns the ID of the account LBU delivers access logs with in a region,
+// or an empty string if the region is unknown.
+func LBUServiceAccountIdForRegion(region string) string {
+	return lbuServiceAccountIdPerRegionMap[region]
+}
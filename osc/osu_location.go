@@ -0,0 +1,81 @@
+package osc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// osuPresignExpiry is how long the pre-signed URLs handed to FCU for
+// snapshot and image imports stay valid. Imports read the object in the
+// background, so this needs to outlive the whole import.
+const osuPresignExpiry = 24 * time.Hour
+
+// parseOsuLocation splits an OSU object location of the form
+// "bucket/key" (optionally prefixed with "osu://" or "s3://") into its
+// bucket and key.
+func parseOsuLocation(location string) (string, string, error) {
+	l := location
+	for _, scheme := range []string{"osu://", "s3://"} {
+		l = strings.TrimPrefix(l, scheme)
+	}
+
+	parts := strings.SplitN(l, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("OSU location %q must be of the form bucket/key", location)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func validateOsuLocation(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := parseOsuLocation(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// osuPresignedURL returns a pre-signed GET URL for the object at the given
+// OSU location, suitable for the import calls of FCU.
+func osuPresignedURL(conn *s3.S3, location string) (string, error) {
+	bucket, key, err := parseOsuLocation(location)
+	if err != nil {
+		return "", err
+	}
+
+	req, _ := conn.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	url, err := req.Presign(osuPresignExpiry)
+	if err != nil {
+		return "", fmt.Errorf("Error pre-signing OSU object %s: %s", location, err)
+	}
+
+	return url, nil
+}
+
+// osuObjectSize returns the size, in bytes, of the object at the given OSU
+// location.
+func osuObjectSize(conn *s3.S3, location string) (int64, error) {
+	bucket, key, err := parseOsuLocation(location)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := conn.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Error reading OSU object %s: %s", location, err)
+	}
+	if resp.ContentLength == nil {
+		return 0, fmt.Errorf("OSU object %s has no content length", location)
+	}
+
+	return *resp.ContentLength, nil
+}
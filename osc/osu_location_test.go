@@ -0,0 +1,37 @@
+package osc
+
+import "testing"
+
+func TestParseOsuLocation(t *testing.T) {
+	cases := []struct {
+		Location string
+		Bucket   string
+		Key      string
+		Error    bool
+	}{
+		{Location: "images/ubuntu.qcow2", Bucket: "images", Key: "ubuntu.qcow2"},
+		{Location: "images/golden/ubuntu.raw", Bucket: "images", Key: "golden/ubuntu.raw"},
+		{Location: "osu://images/ubuntu.qcow2", Bucket: "images", Key: "ubuntu.qcow2"},
+		{Location: "s3://images/manifest.xml", Bucket: "images", Key: "manifest.xml"},
+		{Location: "images", Error: true},
+		{Location: "images/", Error: true},
+		{Location: "/ubuntu.qcow2", Error: true},
+		{Location: "", Error: true},
+	}
+
+	for _, tc := range cases {
+		bucket, key, err := parseOsuLocation(tc.Location)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("expected error for %q", tc.Location)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", tc.Location, err)
+		}
+		if bucket != tc.Bucket || key != tc.Key {
+			t.Fatalf("%q: expected %s/%s, got %s/%s", tc.Location, tc.Bucket, tc.Key, bucket, key)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package osc
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// Official Outscale images are published under the "Outscale" owner alias.
+// The alias is looked up per region so regions where the catalog is
+// published by another account can be added here.
+var outscaleImageOwnerAliasPerRegionMap = map[string]string{
+	"ap-northeast-1":      "Outscale",
+	"cloudgouv-eu-west-1": "Outscale",
+	"cn-southeast-1":      "Outscale",
+	"eu-west-2":           "Outscale",
+	"us-east-2":           "Outscale",
+	"us-west-1":           "Outscale",
+}
+
+// Returns the owner alias of the official Outscale images in a region, or an
+// empty string if the region is unknown.
+func OutscaleImageOwnerAliasForRegion(region string) string {
+	return outscaleImageOwnerAliasPerRegionMap[region]
+}
+
+// Official Outscale images are named <os>-<os version>-<YYYY.MM.DD>-<build>,
+// e.g. Ubuntu-18.04-2019.07.03-0, CentOS-7-2019.07.04-1 or
+// Windows-Server-2016-2019.06.12-0.
+var outscaleImageNameRegexp = regexp.MustCompile(
+	`^([A-Za-z]+(?:-[A-Za-z]+)*)-([0-9][0-9A-Za-z.]*)-([0-9]{4}\.[0-9]{2}\.[0-9]{2})-([0-9]+)$`)
+
+type outscaleImageName struct {
+	OS        string
+	OSVersion string
+	Date      string
+	Build     int
+}
+
+// Family is the OS and its version, e.g. Ubuntu-18.04.
+func (n *outscaleImageName) Family() string {
+	return fmt.Sprintf("%s-%s", n.OS, n.OSVersion)
+}
+
+// Version is the release of the image within its family, e.g. 2019.07.03-0.
+func (n *outscaleImageName) Version() string {
+	return fmt.Sprintf("%s-%d", n.Date, n.Build)
+}
+
+func parseOutscaleImageName(name string) (*outscaleImageName, bool) {
+	m := outscaleImageNameRegexp.FindStringSubmatch(name)
+	if m == nil {
+		return nil, false
+	}
+
+	build, err := strconv.Atoi(m[4])
+	if err != nil {
+		return nil, false
+	}
+
+	return &outscaleImageName{
+		OS:        m[1],
+		OSVersion: m[2],
+		Date:      m[3],
+		Build:     build,
+	}, true
+}
+
+// Matches reports whether the image belongs to the given family and OS, both
+// compared case-insensitively. Empty values match anything.
+func (n *outscaleImageName) Matches(family, os string) bool {
+	if family != "" && !strings.EqualFold(n.Family(), family) {
+		return false
+	}
+	if os != "" && !strings.EqualFold(n.OS, os) {
+		return false
+	}
+	return true
+}
+
+// compareVersionStrings compares dotted version strings component by
+// component, numerically when both components are numbers.
+func compareVersionStrings(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		if aErr == nil && bErr == nil {
+			if an != bn {
+				if an < bn {
+					return -1
+				}
+				return 1
+			}
+			continue
+		}
+		if c := strings.Compare(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	}
+	return 0
+}
+
+// Less orders images by OS version first, then release date and build
+// number.
+func (n *outscaleImageName) Less(o *outscaleImageName) bool {
+	if c := compareVersionStrings(n.OSVersion, o.OSVersion); c != 0 {
+		return c < 0
+	}
+	if n.Date != o.Date {
+		return n.Date < o.Date
+	}
+	return n.Build < o.Build
+}
+
+type outscaleImage struct {
+	Image *ec2.Image
+	Name  *outscaleImageName
+}
+
+type outscaleImageSort []outscaleImage
+
+func (a outscaleImageSort) Len() int           { return len(a) }
+func (a outscaleImageSort) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a outscaleImageSort) Less(i, j int) bool { return a[i].Name.Less(a[j].Name) }
+
+// filterOutscaleImages keeps the images following the Outscale naming scheme
+// that belong to the given family and OS.
+func filterOutscaleImages(images []*ec2.Image, family, os string) []outscaleImage {
+	var filtered []outscaleImage
+	for _, image := range images {
+		name, ok := parseOutscaleImageName(aws.StringValue(image.Name))
+		if !ok || !name.Matches(family, os) {
+			continue
+		}
+		filtered = append(filtered, outscaleImage{Image: image, Name: name})
+	}
+	return filtered
+}
+
+// Returns the image with the highest version out of a non-empty slice of
+// Outscale images.
+func highestVersionOutscaleImage(images []outscaleImage) outscaleImage {
+	sorted := images
+	sort.Stable(outscaleImageSort(sorted))
+	return sorted[len(sorted)-1]
+}
@@ -0,0 +1,95 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestOutscaleImageOwnerAliasForRegion(t *testing.T) {
+	if r := OutscaleImageOwnerAliasForRegion("eu-west-2"); r != "Outscale" {
+		t.Fatalf("bad: %s", r)
+	}
+
+	// Bad input should be empty string
+	if r := OutscaleImageOwnerAliasForRegion("not-a-region"); r != "" {
+		t.Fatalf("bad: %s", r)
+	}
+}
+
+func TestParseOutscaleImageName(t *testing.T) {
+	cases := []struct {
+		Name      string
+		OK        bool
+		OS        string
+		OSVersion string
+		Version   string
+	}{
+		{"Ubuntu-18.04-2019.07.03-0", true, "Ubuntu", "18.04", "2019.07.03-0"},
+		{"CentOS-7-2019.07.04-12", true, "CentOS", "7", "2019.07.04-12"},
+		{"Windows-Server-2016-2019.06.12-0", true, "Windows-Server", "2016", "2019.06.12-0"},
+		{"my-golden-image", false, "", "", ""},
+		{"Ubuntu-18.04-2019.07.03", false, "", "", ""},
+		{"", false, "", "", ""},
+	}
+
+	for _, tc := range cases {
+		n, ok := parseOutscaleImageName(tc.Name)
+		if ok != tc.OK {
+			t.Fatalf("%q: expected ok to be %t", tc.Name, tc.OK)
+		}
+		if !ok {
+			continue
+		}
+		if n.OS != tc.OS || n.OSVersion != tc.OSVersion || n.Version() != tc.Version {
+			t.Fatalf("%q: bad parse: %#v", tc.Name, n)
+		}
+	}
+}
+
+func TestCompareVersionStrings(t *testing.T) {
+	cases := []struct {
+		A, B     string
+		Expected int
+	}{
+		{"18.04", "18.04", 0},
+		{"16.04", "18.04", -1},
+		{"7", "6", 1},
+		{"7.10", "7.9", 1},
+		{"7", "7.1", -1},
+		{"2016", "2019", -1},
+	}
+
+	for _, tc := range cases {
+		if c := compareVersionStrings(tc.A, tc.B); c != tc.Expected {
+			t.Fatalf("compareVersionStrings(%q, %q) = %d, expected %d", tc.A, tc.B, c, tc.Expected)
+		}
+	}
+}
+
+func TestHighestVersionOutscaleImage(t *testing.T) {
+	images := []*ec2.Image{
+		// Created last, but an older release of the family.
+		{ImageId: aws.String("ami-1"), Name: aws.String("Ubuntu-18.04-2019.05.01-3"), CreationDate: aws.String("2019-09-01T00:00:00.000Z")},
+		{ImageId: aws.String("ami-2"), Name: aws.String("Ubuntu-18.04-2019.07.03-0"), CreationDate: aws.String("2019-07-03T00:00:00.000Z")},
+		{ImageId: aws.String("ami-3"), Name: aws.String("Ubuntu-18.04-2019.07.03-1"), CreationDate: aws.String("2019-07-04T00:00:00.000Z")},
+		{ImageId: aws.String("ami-4"), Name: aws.String("Ubuntu-16.04-2019.08.01-0"), CreationDate: aws.String("2019-08-01T00:00:00.000Z")},
+		{ImageId: aws.String("ami-5"), Name: aws.String("CentOS-7-2019.09.01-0"), CreationDate: aws.String("2019-09-01T00:00:00.000Z")},
+		{ImageId: aws.String("ami-6"), Name: aws.String("not-an-outscale-image")},
+	}
+
+	latest := highestVersionOutscaleImage(filterOutscaleImages(images, "ubuntu-18.04", ""))
+	if *latest.Image.ImageId != "ami-3" {
+		t.Fatalf("expected ami-3, got %s", *latest.Image.ImageId)
+	}
+
+	latest = highestVersionOutscaleImage(filterOutscaleImages(images, "", "Ubuntu"))
+	if *latest.Image.ImageId != "ami-3" {
+		t.Fatalf("expected ami-3, got %s", *latest.Image.ImageId)
+	}
+
+	if filtered := filterOutscaleImages(images, "", "windows"); len(filtered) != 0 {
+		t.Fatalf("expected no windows image, got %d", len(filtered))
+	}
+}
@@ -118,33 +118,47 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"osc_ami":                     dataSourceAwsAmi(),
-			"osc_availability_zone":       dataSourceAwsAvailabilityZone(),
-			"osc_availability_zones":      dataSourceAwsAvailabilityZones(),
-			"osc_billing_service_account": dataSourceAwsBillingServiceAccount(),
-			"osc_caller_identity":         dataSourceAwsCallerIdentity(),
-			"osc_canonical_user_id":       dataSourceAwsCanonicalUserId(),
-			"osc_ebs_snapshot":            dataSourceAwsEbsSnapshot(),
-			"osc_ebs_volume":              dataSourceAwsEbsVolume(),
-			"osc_eip":                     dataSourceAwsEip(),
-			"osc_elb_hosted_zone_id":      dataSourceAwsElbHostedZoneId(),
-			"osc_elb_service_account":     dataSourceAwsElbServiceAccount(),
-			"osc_iam_account_alias":       dataSourceAwsIamAccountAlias(),
-			"osc_iam_policy_document":     dataSourceAwsIamPolicyDocument(),
-			"osc_iam_server_certificate":  dataSourceAwsIAMServerCertificate(),
-			"osc_instance":                dataSourceAwsInstance(),
-			"osc_ip_ranges":               dataSourceAwsIPRanges(),
-			"osc_partition":               dataSourceAwsPartition(),
-			"osc_prefix_list":             dataSourceAwsPrefixList(),
-			"osc_region":                  dataSourceAwsRegion(),
-			"osc_route_table":             dataSourceAwsRouteTable(),
-			"osc_s3_bucket_object":        dataSourceAwsS3BucketObject(),
-			"osc_subnet":                  dataSourceAwsSubnet(),
-			"osc_security_group":          dataSourceAwsSecurityGroup(),
-			"osc_vpc":                     dataSourceAwsVpc(),
-			"osc_vpc_endpoint":            dataSourceAwsVpcEndpoint(),
-			"osc_vpc_endpoint_service":    dataSourceAwsVpcEndpointService(),
-			"osc_vpc_peering_connection":  dataSourceAwsVpcPeeringConnection(),
+			"osc_ami":                          dataSourceAwsAmi(),
+			"osc_availability_zone":            dataSourceAwsAvailabilityZone(),
+			"osc_availability_zones":           dataSourceAwsAvailabilityZones(),
+			"osc_billing_service_account":      dataSourceAwsBillingServiceAccount(),
+			"osc_caller_identity":              dataSourceAwsCallerIdentity(),
+			"osc_canonical_user_id":            dataSourceAwsCanonicalUserId(),
+			"osc_customer_gateway":             dataSourceAwsCustomerGateway(),
+			"osc_ebs_snapshot":                 dataSourceAwsEbsSnapshot(),
+			"osc_ebs_volume":                   dataSourceAwsEbsVolume(),
+			"osc_eip":                          dataSourceAwsEip(),
+			"osc_elb":                          dataSourceAwsElb(),
+			"osc_elb_access_log_bucket_policy": dataSourceAwsElbAccessLogBucketPolicy(),
+			"osc_elb_hosted_zone_id":           dataSourceAwsElbHostedZoneId(),
+			"osc_elb_instance_health":          dataSourceAwsElbInstanceHealth(),
+			"osc_elb_service_account":          dataSourceAwsElbServiceAccount(),
+			"osc_iam_account_alias":            dataSourceAwsIamAccountAlias(),
+			"osc_iam_policy_document":          dataSourceAwsIamPolicyDocument(),
+			"osc_iam_server_certificate":       dataSourceAwsIAMServerCertificate(),
+			"osc_instance":                     dataSourceAwsInstance(),
+			"osc_internet_gateway":             dataSourceAwsInternetGateway(),
+			"osc_ip_ranges":                    dataSourceAwsIPRanges(),
+			"osc_lb_ssl_policies":              dataSourceAwsLBSSLPolicies(),
+			"osc_nat_gateway":                  dataSourceAwsNatGateway(),
+			"osc_network_interface":            dataSourceAwsNetworkInterface(),
+			"osc_partition":                    dataSourceAwsPartition(),
+			"osc_prefix_list":                  dataSourceAwsPrefixList(),
+			"osc_region":                       dataSourceAwsRegion(),
+			"osc_route_table":                  dataSourceAwsRouteTable(),
+			"osc_s3_bucket_object":             dataSourceAwsS3BucketObject(),
+			"osc_subnet":                       dataSourceAwsSubnet(),
+			"osc_subnet_ids":                   dataSourceAwsSubnetIDs(),
+			"osc_security_group":               dataSourceAwsSecurityGroup(),
+			"osc_security_groups":              dataSourceAwsSecurityGroups(),
+			"osc_vpc":                          dataSourceAwsVpc(),
+			"osc_vpcs":                         dataSourceAwsVpcs(),
+			"osc_vpc_endpoint":                 dataSourceAwsVpcEndpoint(),
+			"osc_vpc_endpoint_service":         dataSourceAwsVpcEndpointService(),
+			"osc_vpc_peering_connection":       dataSourceAwsVpcPeeringConnection(),
+			"osc_vpn_connection":               dataSourceAwsVpnConnection(),
+			"osc_vpn_connection_device_config": dataSourceAwsVpnConnectionDeviceConfig(),
+			"osc_vpn_gateway":                  dataSourceAwsVpnGateway(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -152,6 +166,7 @@ func Provider() terraform.ResourceProvider {
 			"osc_ami_copy":                             resourceAwsAmiCopy(),
 			"osc_ami_from_instance":                    resourceAwsAmiFromInstance(),
 			"osc_ami_launch_permission":                resourceAwsAmiLaunchPermission(),
+			"osc_ami_launch_permissions":               resourceAwsAmiLaunchPermissions(),
 			"osc_api_gateway_account":                  resourceAwsApiGatewayAccount(),
 			"osc_api_gateway_api_key":                  resourceAwsApiGatewayApiKey(),
 			"osc_api_gateway_authorizer":               resourceAwsApiGatewayAuthorizer(),
@@ -172,6 +187,7 @@ func Provider() terraform.ResourceProvider {
 			"osc_eip_association":                      resourceAwsEipAssociation(),
 			"osc_elb":                                  resourceAwsElb(),
 			"osc_elb_attachment":                       resourceAwsElbAttachment(),
+			"osc_elb_backends":                         resourceAwsElbBackends(),
 			"osc_flow_log":                             resourceAwsFlowLog(),
 			"osc_iam_access_key":                       resourceAwsIamAccessKey(),
 			"osc_iam_account_password_policy":          resourceAwsIamAccountPasswordPolicy(),
@@ -207,6 +223,8 @@ func Provider() terraform.ResourceProvider {
 			"osc_default_route_table":                  resourceAwsDefaultRouteTable(),
 			"osc_network_acl_rule":                     resourceAwsNetworkAclRule(),
 			"osc_network_interface":                    resourceAwsNetworkInterface(),
+			"osc_network_interface_attachment":         resourceAwsNetworkInterfaceAttachment(),
+			"osc_network_interface_sg_attachment":      resourceAwsNetworkInterfaceSGAttachment(),
 			"osc_placement_group":                      resourceAwsPlacementGroup(),
 			"osc_proxy_protocol_policy":                resourceAwsProxyProtocolPolicy(),
 			"osc_route":                                resourceAwsRoute(),
@@ -217,9 +235,13 @@ func Provider() terraform.ResourceProvider {
 			"osc_s3_bucket_object":                     resourceAwsS3BucketObject(),
 			"osc_s3_bucket_notification":               resourceAwsS3BucketNotification(),
 			"osc_default_security_group":               resourceAwsDefaultSecurityGroup(),
+			"osc_default_subnet":                       resourceAwsDefaultSubnet(),
+			"osc_default_vpc":                          resourceAwsDefaultVpc(),
 			"osc_security_group":                       resourceAwsSecurityGroup(),
 			"osc_security_group_rule":                  resourceAwsSecurityGroupRule(),
+			"osc_security_group_rules":                 resourceAwsSecurityGroupRules(),
 			"osc_snapshot_create_volume_permission":    resourceAwsSnapshotCreateVolumePermission(),
+			"osc_snapshot_permissions":                 resourceAwsSnapshotPermissions(),
 			"osc_subnet":                               resourceAwsSubnet(),
 			"osc_volume_attachment":                    resourceAwsVolumeAttachment(),
 			"osc_vpc_dhcp_options_association":         resourceAwsVpcDhcpOptionsAssociation(),
@@ -233,6 +255,7 @@ func Provider() terraform.ResourceProvider {
 			"osc_vpn_connection_route":                 resourceAwsVpnConnectionRoute(),
 			"osc_vpn_gateway":                          resourceAwsVpnGateway(),
 			"osc_vpn_gateway_attachment":               resourceAwsVpnGatewayAttachment(),
+			"osc_vpn_gateway_route_propagation":        resourceAwsVpnGatewayRoutePropagation(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
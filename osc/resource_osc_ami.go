@@ -20,23 +20,48 @@ func resourceAwsAmi() *schema.Resource {
 	// Our schema is shared also with aws_ami_copy and aws_ami_from_instance
 	resourceSchema := resourceAwsAmiCommonSchema(false)
 
+	// Registers the image from a manifest stored in OSU, which is pre-signed
+	// on the user's behalf and handed to FCU as the image location.
+	resourceSchema["file_location"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"image_location", "ebs_block_device", "ephemeral_block_device", "root_device_name"},
+		ValidateFunc:  validateOsuLocation,
+	}
+	// These are read from the manifest when importing, so whatever is (or
+	// defaults to being) configured is meaningless once file_location is set.
+	for _, k := range []string{"architecture", "kernel_id", "ramdisk_id", "sriov_net_support", "virtualization_type"} {
+		resourceSchema[k].DiffSuppressFunc = suppressAmiManifestAttribute
+	}
+
 	return &schema.Resource{
 		Create: resourceAwsAmiCreate,
 
 		Schema: resourceSchema,
 
+		Timeouts: resourceAwsAmiTimeouts(),
+
 		// The Read, Update and Delete operations are shared with aws_ami_copy
 		// and aws_ami_from_instance, since they differ only in how the image
 		// is created.
 		Read:   resourceAwsAmiRead,
 		Update: resourceAwsAmiUpdate,
 		Delete: resourceAwsAmiDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 	}
 }
 
 func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).ec2conn
 
+	if _, ok := d.GetOk("file_location"); ok {
+		return resourceAwsAmiCreateFromManifest(d, meta)
+	}
+
 	req := &ec2.RegisterImageInput{
 		Name:               aws.String(d.Get("name").(string)),
 		Description:        aws.String(d.Get("description").(string)),
@@ -109,7 +134,7 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("manage_ebs_block_devices")
 	d.Partial(false)
 
-	_, err = resourceAwsAmiWaitForAvailable(id, client)
+	_, err = resourceAwsAmiWaitForAvailable(id, client, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -117,6 +142,54 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceAwsAmiUpdate(d, meta)
 }
 
+// resourceAwsAmiCreateFromManifest registers an image from a manifest stored
+// in OSU. FCU imports the disks referenced by the manifest in the background
+// and only makes the image available once they have all been copied, so the
+// wait here can be much longer than for a plain registration.
+func resourceAwsAmiCreateFromManifest(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient).ec2conn
+	location := d.Get("file_location").(string)
+
+	url, err := osuPresignedURL(meta.(*AWSClient).s3conn, location)
+	if err != nil {
+		return err
+	}
+
+	req := &ec2.RegisterImageInput{
+		Name:          aws.String(d.Get("name").(string)),
+		ImageLocation: aws.String(url),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Registering AMI from manifest %s", location)
+	res, err := client.RegisterImage(req)
+	if err != nil {
+		return fmt.Errorf("Error registering AMI from manifest %s: %s", location, err)
+	}
+
+	id := *res.ImageId
+	d.SetId(id)
+	d.Partial(true) // make sure we record the id even if the rest of this gets interrupted
+	d.Set("id", id)
+	d.Set("manage_ebs_snapshots", false)
+	d.SetPartial("id")
+	d.SetPartial("manage_ebs_snapshots")
+	d.Partial(false)
+
+	if _, err := resourceAwsAmiWaitForAvailable(id, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for AMI import from %s: %s", location, err)
+	}
+
+	return resourceAwsAmiUpdate(d, meta)
+}
+
+func suppressAmiManifestAttribute(k, old, new string, d *schema.ResourceData) bool {
+	_, ok := d.GetOk("file_location")
+	return ok
+}
+
 func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).ec2conn
 	id := d.Id()
@@ -150,7 +223,7 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 		// before we continue. We should never take this branch in normal
 		// circumstances since we would've waited for availability during
 		// the "Create" step.
-		image, err = resourceAwsAmiWaitForAvailable(id, client)
+		image, err = resourceAwsAmiWaitForAvailable(id, client, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -176,41 +249,45 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("sriov_net_support", image.SriovNetSupport)
 	d.Set("virtualization_type", image.VirtualizationType)
 
+	ebsBlockDevs, ephemeralBlockDevs := flattenAmiBlockDeviceMappings(image.BlockDeviceMappings)
+
+	d.Set("ebs_block_device", ebsBlockDevs)
+	d.Set("ephemeral_block_device", ephemeralBlockDevs)
+
+	d.Set("tags", tagsToMap(image.Tags))
+
+	return nil
+}
+
+// flattenAmiBlockDeviceMappings rebuilds the ebs_block_device and
+// ephemeral_block_device sets from the mappings returned by DescribeImages,
+// which is all we have to go on when an image is imported.
+func flattenAmiBlockDeviceMappings(mappings []*ec2.BlockDeviceMapping) ([]map[string]interface{}, []map[string]interface{}) {
 	var ebsBlockDevs []map[string]interface{}
 	var ephemeralBlockDevs []map[string]interface{}
 
-	for _, blockDev := range image.BlockDeviceMappings {
+	for _, blockDev := range mappings {
 		if blockDev.Ebs != nil {
 			ebsBlockDev := map[string]interface{}{
-				"device_name":           *blockDev.DeviceName,
-				"delete_on_termination": *blockDev.Ebs.DeleteOnTermination,
-				"encrypted":             *blockDev.Ebs.Encrypted,
-				"iops":                  0,
-				"volume_size":           int(*blockDev.Ebs.VolumeSize),
-				"volume_type":           *blockDev.Ebs.VolumeType,
-			}
-			if blockDev.Ebs.Iops != nil {
-				ebsBlockDev["iops"] = int(*blockDev.Ebs.Iops)
-			}
-			// The snapshot ID might not be set.
-			if blockDev.Ebs.SnapshotId != nil {
-				ebsBlockDev["snapshot_id"] = *blockDev.Ebs.SnapshotId
+				"device_name":           aws.StringValue(blockDev.DeviceName),
+				"delete_on_termination": aws.BoolValue(blockDev.Ebs.DeleteOnTermination),
+				"encrypted":             aws.BoolValue(blockDev.Ebs.Encrypted),
+				"iops":                  int(aws.Int64Value(blockDev.Ebs.Iops)),
+				"volume_size":           int(aws.Int64Value(blockDev.Ebs.VolumeSize)),
+				"volume_type":           aws.StringValue(blockDev.Ebs.VolumeType),
+				// The snapshot ID might not be set.
+				"snapshot_id": aws.StringValue(blockDev.Ebs.SnapshotId),
 			}
 			ebsBlockDevs = append(ebsBlockDevs, ebsBlockDev)
-		} else {
+		} else if blockDev.VirtualName != nil {
 			ephemeralBlockDevs = append(ephemeralBlockDevs, map[string]interface{}{
-				"device_name":  *blockDev.DeviceName,
+				"device_name":  aws.StringValue(blockDev.DeviceName),
 				"virtual_name": *blockDev.VirtualName,
 			})
 		}
 	}
 
-	d.Set("ebs_block_device", ebsBlockDevs)
-	d.Set("ephemeral_block_device", ephemeralBlockDevs)
-
-	d.Set("tags", tagsToMap(image.Tags))
-
-	return nil
+	return ebsBlockDevs, ephemeralBlockDevs
 }
 
 func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -224,7 +301,7 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("tags")
 	}
 
-	if d.Get("description").(string) != "" {
+	if d.HasChange("description") {
 		_, err := client.ModifyImageAttribute(&ec2.ModifyImageAttributeInput{
 			ImageId: aws.String(d.Id()),
 			Description: &ec2.AttributeValue{
@@ -286,14 +363,25 @@ func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAwsAmiWaitForAvailable(id string, client *ec2.EC2) (*ec2.Image, error) {
+func resourceAwsAmiTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(40 * time.Minute),
+	}
+}
+
+func resourceAwsAmiWaitForAvailable(id string, client *ec2.EC2, timeout time.Duration) (*ec2.Image, error) {
 	log.Printf("Waiting for AMI %s to become available...", id)
 
 	req := &ec2.DescribeImagesInput{
 		ImageIds: []*string{aws.String(id)},
 	}
+	deadline := time.Now().Add(timeout)
 	pollsWhereNotFound := 0
 	for {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout after %s waiting for AMI %s to become available", timeout, id)
+		}
+
 		res, err := client.DescribeImages(req)
 		if err != nil {
 			// When using RegisterImage (for aws_ami) the AMI sometimes isn't available at all
@@ -330,8 +418,12 @@ func resourceAwsAmiWaitForAvailable(id string, client *ec2.EC2) (*ec2.Image, err
 		}
 
 		// If we're not pending or available then we're in one of the invalid/error
-		// states, so stop polling and bail out.
-		stateReason := *res.Images[0].StateReason
+		// states, so stop polling and bail out. Failed imports don't always
+		// come with a reason.
+		stateReason := "unknown reason"
+		if reason := res.Images[0].StateReason; reason != nil {
+			stateReason = aws.StringValue(reason.Message)
+		}
 		return nil, fmt.Errorf("new AMI became %s while pending: %s", state, stateReason)
 	}
 }
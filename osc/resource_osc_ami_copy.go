@@ -1,6 +1,9 @@
 package osc
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 
@@ -44,14 +47,40 @@ func resourceAwsAmiCopy() *schema.Resource {
 
 		Schema: resourceSchema,
 
+		Timeouts: resourceAwsAmiTimeouts(),
+
 		// The remaining operations are shared with the generic aws_ami resource,
 		// since the aws_ami_copy resource only differs in how it's created.
 		Read:   resourceAwsAmiRead,
 		Update: resourceAwsAmiUpdate,
 		Delete: resourceAwsAmiDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAmiCopyImport,
+		},
 	}
 }
 
+// The source of a copy can't be read back from the image, so it may be given
+// in the import ID as <ami id>:<source ami id>:<source ami region> to avoid
+// a replacement on the next plan.
+func resourceAwsAmiCopyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	switch len(parts) {
+	case 1:
+	case 3:
+		d.Set("source_ami_id", parts[1])
+		d.Set("source_ami_region", parts[2])
+	default:
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected AMI-ID or AMI-ID:SOURCE-AMI-ID:SOURCE-AMI-REGION", d.Id())
+	}
+
+	d.SetId(parts[0])
+	d.Set("manage_ebs_snapshots", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAmiCopyCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).ec2conn
 
@@ -81,7 +110,7 @@ func resourceAwsAmiCopyCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("manage_ebs_snapshots")
 	d.Partial(false)
 
-	_, err = resourceAwsAmiWaitForAvailable(id, client)
+	_, err = resourceAwsAmiWaitForAvailable(id, client, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
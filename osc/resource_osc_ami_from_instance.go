@@ -1,6 +1,9 @@
 package osc
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 
@@ -29,14 +32,39 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 
 		Schema: resourceSchema,
 
+		Timeouts: resourceAwsAmiTimeouts(),
+
 		// The remaining operations are shared with the generic aws_ami resource,
 		// since the aws_ami_copy resource only differs in how it's created.
 		Read:   resourceAwsAmiRead,
 		Update: resourceAwsAmiUpdate,
 		Delete: resourceAwsAmiDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAmiFromInstanceImport,
+		},
 	}
 }
 
+// The source instance can't be read back from the image, so it may be given
+// in the import ID as <ami id>:<instance id> to avoid a replacement on the
+// next plan.
+func resourceAwsAmiFromInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	switch len(parts) {
+	case 1:
+	case 2:
+		d.Set("source_instance_id", parts[1])
+	default:
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected AMI-ID or AMI-ID:INSTANCE-ID", d.Id())
+	}
+
+	d.SetId(parts[0])
+	d.Set("manage_ebs_snapshots", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).ec2conn
 
@@ -61,7 +89,7 @@ func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{})
 	d.SetPartial("manage_ebs_snapshots")
 	d.Partial(false)
 
-	_, err = resourceAwsAmiWaitForAvailable(id, client)
+	_, err = resourceAwsAmiWaitForAvailable(id, client, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
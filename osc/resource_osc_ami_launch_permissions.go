@@ -0,0 +1,190 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAwsAmiLaunchPermissions manages the whole launch permission list of
+// an image, unlike aws_ami_launch_permission which only adds a single account
+// to it. Permissions granted outside of Terraform show up as a diff and are
+// removed on the next apply.
+func resourceAwsAmiLaunchPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAmiLaunchPermissionsCreate,
+		Read:   resourceAwsAmiLaunchPermissionsRead,
+		Update: resourceAwsAmiLaunchPermissionsUpdate,
+		Delete: resourceAwsAmiLaunchPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAwsAccountId,
+				},
+				Set: schema.HashString,
+			},
+			// Launch permission granted to the "all" group, i.e. the image is
+			// public.
+			"public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsAmiLaunchPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	imageId := d.Get("image_id").(string)
+
+	// Start from what the image is actually shared with, so that accounts
+	// added outside of Terraform are revoked on the first apply.
+	currentIds, currentPublic, err := describeLaunchPermissions(conn, imageId)
+	if err != nil {
+		return fmt.Errorf("Error reading launch permissions of AMI %s: %s", imageId, err)
+	}
+
+	err = updateLaunchPermissions(conn, imageId, currentIds, currentPublic,
+		sharingPermissionsAccountIds(d.Get("account_ids")), d.Get("public").(bool))
+	if err != nil {
+		return fmt.Errorf("Error modifying launch permissions of AMI %s: %s", imageId, err)
+	}
+
+	d.SetId(imageId)
+
+	return resourceAwsAmiLaunchPermissionsRead(d, meta)
+}
+
+func resourceAwsAmiLaunchPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	accountIds, public, err := describeLaunchPermissions(conn, d.Id())
+	if err != nil {
+		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidAMIID.NotFound" {
+			log.Printf("[WARN] AMI %s not found, removing launch permissions from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading launch permissions of AMI %s: %s", d.Id(), err)
+	}
+
+	d.Set("image_id", d.Id())
+	d.Set("account_ids", accountIds)
+	d.Set("public", public)
+
+	return nil
+}
+
+func resourceAwsAmiLaunchPermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	oIds, nIds := d.GetChange("account_ids")
+	oPublic, nPublic := d.GetChange("public")
+
+	err := updateLaunchPermissions(conn, d.Id(),
+		sharingPermissionsAccountIds(oIds), oPublic.(bool),
+		sharingPermissionsAccountIds(nIds), nPublic.(bool))
+	if err != nil {
+		return fmt.Errorf("Error modifying launch permissions of AMI %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsAmiLaunchPermissionsRead(d, meta)
+}
+
+func resourceAwsAmiLaunchPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	err := updateLaunchPermissions(conn, d.Id(),
+		sharingPermissionsAccountIds(d.Get("account_ids")), d.Get("public").(bool), nil, false)
+	if err != nil {
+		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidAMIID.NotFound" {
+			return nil
+		}
+		return fmt.Errorf("Error removing launch permissions of AMI %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeLaunchPermissions(conn *ec2.EC2, imageId string) ([]string, bool, error) {
+	attrs, err := conn.DescribeImageAttribute(&ec2.DescribeImageAttributeInput{
+		ImageId:   aws.String(imageId),
+		Attribute: aws.String("launchPermission"),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	accountIds, public := flattenLaunchPermissions(attrs.LaunchPermissions)
+	return accountIds, public, nil
+}
+
+func updateLaunchPermissions(conn *ec2.EC2, imageId string, currentIds []string, currentPublic bool, wantedIds []string, wantedPublic bool) error {
+	addIds, removeIds, addPublic, removePublic := sharingPermissionsChanges(
+		currentIds, currentPublic, wantedIds, wantedPublic)
+
+	return modifyLaunchPermissions(conn, imageId,
+		expandLaunchPermissions(addIds, addPublic),
+		expandLaunchPermissions(removeIds, removePublic))
+}
+
+func modifyLaunchPermissions(conn *ec2.EC2, imageId string, add, remove []*ec2.LaunchPermission) error {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Modifying launch permissions of AMI %s: adding %d, removing %d",
+		imageId, len(add), len(remove))
+	_, err := conn.ModifyImageAttribute(&ec2.ModifyImageAttributeInput{
+		ImageId:   aws.String(imageId),
+		Attribute: aws.String("launchPermission"),
+		LaunchPermission: &ec2.LaunchPermissionModifications{
+			Add:    add,
+			Remove: remove,
+		},
+	})
+
+	return err
+}
+
+func expandLaunchPermissions(accountIds []string, public bool) []*ec2.LaunchPermission {
+	var perms []*ec2.LaunchPermission
+	for _, id := range accountIds {
+		perms = append(perms, &ec2.LaunchPermission{UserId: aws.String(id)})
+	}
+	if public {
+		perms = append(perms, &ec2.LaunchPermission{Group: aws.String("all")})
+	}
+	return perms
+}
+
+func flattenLaunchPermissions(perms []*ec2.LaunchPermission) ([]string, bool) {
+	accountIds := make([]string, 0, len(perms))
+	public := false
+	for _, p := range perms {
+		if p.UserId != nil {
+			accountIds = append(accountIds, *p.UserId)
+		}
+		if aws.StringValue(p.Group) == "all" {
+			public = true
+		}
+	}
+	return accountIds, public
+}
@@ -0,0 +1,207 @@
+package osc
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAMILaunchPermissions_basic(t *testing.T) {
+	var imageId string
+	accountId := os.Getenv("AWS_ACCOUNT_ID")
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if accountId == "" {
+				t.Fatal("AWS_ACCOUNT_ID must be set")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAMILaunchPermissionsConfig(rInt, accountId, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceGetAttr("aws_ami_copy.test", "id", &imageId),
+					testAccCheckAWSAMILaunchPermissions(&imageId, []string{accountId}, true),
+					resource.TestCheckResourceAttr("aws_ami_launch_permissions.test", "account_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_ami_launch_permissions.test", "public", "true"),
+				),
+			},
+			{
+				ResourceName:      "aws_ami_launch_permissions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAMILaunchPermissionsConfig(rInt, accountId, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAMILaunchPermissions(&imageId, []string{accountId}, false),
+					resource.TestCheckResourceAttr("aws_ami_launch_permissions.test", "public", "false"),
+				),
+			},
+		},
+	})
+}
+
+// Grants a launch permission behind Terraform's back and checks it gets
+// reported and removed.
+func TestAccAWSAMILaunchPermissions_drift(t *testing.T) {
+	var imageId string
+	accountId := os.Getenv("AWS_ACCOUNT_ID")
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if accountId == "" {
+				t.Fatal("AWS_ACCOUNT_ID must be set")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAMILaunchPermissionsConfig(rInt, accountId, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceGetAttr("aws_ami_copy.test", "id", &imageId),
+					testAccAWSAMILaunchPermissionsMakePublic(&imageId),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSAMILaunchPermissionsConfig(rInt, accountId, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAMILaunchPermissions(&imageId, []string{accountId}, false),
+				),
+			},
+		},
+	})
+}
+
+// Shares the image with another account before the resource exists and
+// checks the first apply revokes it.
+func TestAccAWSAMILaunchPermissions_preShared(t *testing.T) {
+	var imageId string
+	accountId := os.Getenv("AWS_ACCOUNT_ID")
+	altAccountId := os.Getenv("AWS_ALTERNATE_ACCOUNT_ID")
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if accountId == "" || altAccountId == "" {
+				t.Fatal("AWS_ACCOUNT_ID and AWS_ALTERNATE_ACCOUNT_ID must be set")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAMILaunchPermissionsConfigImageOnly(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceGetAttr("aws_ami_copy.test", "id", &imageId),
+					testAccAWSAMILaunchPermissionsShare(&imageId, altAccountId),
+				),
+			},
+			{
+				Config: testAccAWSAMILaunchPermissionsConfig(rInt, accountId, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAMILaunchPermissions(&imageId, []string{accountId}, false),
+					resource.TestCheckResourceAttr("aws_ami_launch_permissions.test", "account_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenLaunchPermissions(t *testing.T) {
+	accountIds, public := flattenLaunchPermissions([]*ec2.LaunchPermission{
+		{UserId: aws.String("123456789012")},
+		{Group: aws.String("all")},
+		{UserId: aws.String("210987654321")},
+	})
+
+	if !public {
+		t.Fatal("expected the all group to be flattened as public")
+	}
+	expected := []string{"123456789012", "210987654321"}
+	if !reflect.DeepEqual(accountIds, expected) {
+		t.Fatalf("expected %v, got %v", expected, accountIds)
+	}
+
+	accountIds, public = flattenLaunchPermissions(nil)
+	if public || len(accountIds) != 0 {
+		t.Fatalf("expected no permissions, got %v (public: %t)", accountIds, public)
+	}
+}
+
+func testAccCheckAWSAMILaunchPermissions(imageId *string, accountIds []string, public bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		attrs, err := conn.DescribeImageAttribute(&ec2.DescribeImageAttributeInput{
+			ImageId:   imageId,
+			Attribute: aws.String("launchPermission"),
+		})
+		if err != nil {
+			return err
+		}
+
+		actualIds, actualPublic := flattenLaunchPermissions(attrs.LaunchPermissions)
+		if !reflect.DeepEqual(actualIds, accountIds) {
+			return fmt.Errorf("expected launch permissions for %v on %s, got %v", accountIds, *imageId, actualIds)
+		}
+		if actualPublic != public {
+			return fmt.Errorf("expected %s public to be %t, got %t", *imageId, public, actualPublic)
+		}
+		return nil
+	}
+}
+
+func testAccAWSAMILaunchPermissionsMakePublic(imageId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		return modifyLaunchPermissions(conn, *imageId, expandLaunchPermissions(nil, true), nil)
+	}
+}
+
+func testAccAWSAMILaunchPermissionsShare(imageId *string, accountId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		return modifyLaunchPermissions(conn, *imageId, expandLaunchPermissions([]string{accountId}, false), nil)
+	}
+}
+
+func testAccAWSAMILaunchPermissionsConfigImageOnly(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_ami_copy" "test" {
+  name = "launch-permissions-test-%d"
+  description = "Launch Permissions Test Copy"
+  source_ami_id = "ami-7172b611"
+  source_ami_region = "us-west-2"
+}
+`, rInt)
+}
+
+func testAccAWSAMILaunchPermissionsConfig(rInt int, accountId string, public bool) string {
+	return fmt.Sprintf(`
+resource "aws_ami_copy" "test" {
+  name = "launch-permissions-test-%d"
+  description = "Launch Permissions Test Copy"
+  source_ami_id = "ami-7172b611"
+  source_ami_region = "us-west-2"
+}
+
+resource "aws_ami_launch_permissions" "test" {
+  image_id    = "${aws_ami_copy.test.id}"
+  account_ids = ["%s"]
+  public      = %t
+}
+`, rInt, accountId, public)
+}
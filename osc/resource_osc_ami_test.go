@@ -3,6 +3,8 @@ package osc
 import (
 	"fmt"
 	"log"
+	"os"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -33,6 +35,111 @@ func TestAccAWSAMI_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSAMI_tagsAndDescription(t *testing.T) {
+	var before, after ec2.Image
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfig_tags(rInt, "first", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists("aws_ami.foo", &before),
+					resource.TestCheckResourceAttr("aws_ami.foo", "description", "first"),
+					resource.TestCheckResourceAttr("aws_ami.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_ami.foo", "tags.Name", "foo"),
+				),
+			},
+			{
+				Config: testAccAmiConfig_tags(rInt, "second", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists("aws_ami.foo", &after),
+					testAccCheckAmiNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr("aws_ami.foo", "description", "second"),
+					resource.TestCheckResourceAttr("aws_ami.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_ami.foo", "tags.Name", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenAmiBlockDeviceMappings(t *testing.T) {
+	ebs, ephemeral := flattenAmiBlockDeviceMappings([]*ec2.BlockDeviceMapping{
+		{
+			DeviceName: aws.String("/dev/sda1"),
+			Ebs: &ec2.EbsBlockDevice{
+				DeleteOnTermination: aws.Bool(true),
+				SnapshotId:          aws.String("snap-12345678"),
+				VolumeSize:          aws.Int64(10),
+				VolumeType:          aws.String("gp2"),
+			},
+		},
+		{
+			DeviceName:  aws.String("/dev/sdb"),
+			VirtualName: aws.String("ephemeral0"),
+		},
+		{
+			DeviceName: aws.String("/dev/sdc"),
+			NoDevice:   aws.String(""),
+		},
+	})
+
+	if len(ebs) != 1 {
+		t.Fatalf("expected 1 EBS block device, got %d", len(ebs))
+	}
+	expected := map[string]interface{}{
+		"device_name":           "/dev/sda1",
+		"delete_on_termination": true,
+		"encrypted":             false,
+		"iops":                  0,
+		"snapshot_id":           "snap-12345678",
+		"volume_size":           10,
+		"volume_type":           "gp2",
+	}
+	if !reflect.DeepEqual(ebs[0], expected) {
+		t.Fatalf("expected %#v, got %#v", expected, ebs[0])
+	}
+
+	if len(ephemeral) != 1 || ephemeral[0]["virtual_name"] != "ephemeral0" {
+		t.Fatalf("bad ephemeral block devices: %#v", ephemeral)
+	}
+}
+
+func TestAccAWSAMI_fileLocation(t *testing.T) {
+	var ami ec2.Image
+	rInt := acctest.RandInt()
+	location := os.Getenv("OSC_AMI_MANIFEST_LOCATION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if location == "" {
+				t.Fatal("OSC_AMI_MANIFEST_LOCATION must be set to the bucket/key of an image manifest in OSU")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfig_fileLocation(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists("aws_ami.foo", &ami),
+					resource.TestCheckResourceAttr(
+						"aws_ami.foo", "name", fmt.Sprintf("tf-testing-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"aws_ami.foo", "file_location", location),
+					resource.TestCheckResourceAttrSet(
+						"aws_ami.foo", "root_device_name"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAMI_snapshotSize(t *testing.T) {
 	var ami ec2.Image
 	var bd ec2.BlockDeviceMapping
@@ -120,6 +227,15 @@ func testAccCheckAmiExists(n string, ami *ec2.Image) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckAmiNotRecreated(before, after *ec2.Image) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *before.ImageId != *after.ImageId {
+			return fmt.Errorf("AMI was recreated: %s, now %s", *before.ImageId, *after.ImageId)
+		}
+		return nil
+	}
+}
+
 func testAccCheckAmiBlockDevice(ami *ec2.Image, blockDevice *ec2.BlockDeviceMapping, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		devices := make(map[string]*ec2.BlockDeviceMapping)
@@ -203,6 +319,43 @@ resource "aws_ami" "foo" {
 	`, rInt)
 }
 
+func testAccAmiConfig_tags(rInt int, description, name string) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_volume" "foo" {
+ 	availability_zone = "us-west-2a"
+ 	size = 8
+}
+
+resource "aws_ebs_snapshot" "foo" {
+  volume_id = "${aws_ebs_volume.foo.id}"
+}
+
+resource "aws_ami" "foo" {
+  name = "tf-testing-%d"
+  description = "%s"
+  virtualization_type = "hvm"
+  root_device_name = "/dev/sda1"
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = "${aws_ebs_snapshot.foo.id}"
+  }
+  tags {
+    Name = "%s"
+  }
+}
+	`, rInt, description, name)
+}
+
+func testAccAmiConfig_fileLocation(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "aws_ami" "foo" {
+  name = "tf-testing-%d"
+  description = "AMI imported from OSU"
+  file_location = "%s"
+}
+	`, rInt, location)
+}
+
 func testAccAmiConfig_snapshotSize(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_ebs_volume" "foo" {
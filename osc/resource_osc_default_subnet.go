@@ -0,0 +1,78 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDefaultSubnet() *schema.Resource {
+	// reuse aws_subnet schema, and methods for READ, UPDATE
+	dsubnet := resourceAwsSubnet()
+	dsubnet.Create = resourceAwsDefaultSubnetCreate
+	dsubnet.Delete = resourceAwsDefaultSubnetDelete
+
+	// The default subnet of an availability zone is picked by its zone, the
+	// rest is given by the default VPC
+	dsubnet.Schema["availability_zone"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	dsubnet.Schema["vpc_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	dsubnet.Schema["cidr_block"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	// Default subnets map public IPs on launch, leaving the attribute out
+	// must not turn it off
+	dsubnet.Schema["map_public_ip_on_launch"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+	}
+
+	return dsubnet
+}
+
+func resourceAwsDefaultSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	az := d.Get("availability_zone").(string)
+	req := &ec2.DescribeSubnetsInput{
+		Filters: buildEC2AttributeFilterList(
+			map[string]string{
+				"availabilityZone": az,
+				"defaultForAz":     "true",
+			},
+		),
+	}
+
+	log.Printf("[DEBUG] Commandeer Default Subnet: %s", req)
+	resp, err := conn.DescribeSubnets(req)
+	if err != nil {
+		return fmt.Errorf("Error reading Default Subnet of %s: %s", az, err)
+	}
+	if len(resp.Subnets) != 1 {
+		return fmt.Errorf("[ERR] Error finding default subnet in availability zone %s; found (%d) subnets",
+			az, len(resp.Subnets))
+	}
+
+	d.SetId(aws.StringValue(resp.Subnets[0].SubnetId))
+
+	log.Printf("[INFO] Default Subnet ID: %s", d.Id())
+
+	return resourceAwsSubnetUpdate(d, meta)
+}
+
+func resourceAwsDefaultSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Cannot destroy Default Subnet. Terraform will remove this resource from the state file, however resources may remain.")
+	d.SetId("")
+	return nil
+}
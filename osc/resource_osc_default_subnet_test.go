@@ -0,0 +1,71 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDefaultSubnet_basic(t *testing.T) {
+	var v ec2.Subnet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDefaultSubnetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDefaultSubnetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists("aws_default_subnet.foo", &v),
+					resource.TestCheckResourceAttr("aws_default_subnet.foo", "availability_zone", "us-west-2a"),
+					resource.TestCheckResourceAttr("aws_default_subnet.foo", "map_public_ip_on_launch", "true"),
+					resource.TestCheckResourceAttr("aws_default_subnet.foo", "tags.Name", "Default subnet for us-west-2a"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSDefaultSubnetConfigNoPublicIp,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists("aws_default_subnet.foo", &v),
+					resource.TestCheckResourceAttr("aws_default_subnet.foo", "map_public_ip_on_launch", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDefaultSubnetDestroy(s *terraform.State) error {
+	// We expect the default subnet to still exist
+	return nil
+}
+
+const testAccAWSDefaultSubnetConfig = `
+provider "aws" {
+    region = "us-west-2"
+}
+
+resource "aws_default_subnet" "foo" {
+	availability_zone = "us-west-2a"
+
+	tags {
+		Name = "Default subnet for us-west-2a"
+	}
+}
+`
+
+const testAccAWSDefaultSubnetConfigNoPublicIp = `
+provider "aws" {
+    region = "us-west-2"
+}
+
+resource "aws_default_subnet" "foo" {
+	availability_zone = "us-west-2a"
+	map_public_ip_on_launch = false
+
+	tags {
+		Name = "Default subnet for us-west-2a"
+	}
+}
+`
@@ -0,0 +1,62 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDefaultVpc() *schema.Resource {
+	// reuse aws_vpc schema, and methods for READ, UPDATE
+	dvpc := resourceAwsVpc()
+	dvpc.Create = resourceAwsDefaultVpcCreate
+	dvpc.Delete = resourceAwsDefaultVpcDelete
+
+	// The default VPC and its CIDR block are given by the region
+	dvpc.Schema["cidr_block"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	dvpc.Schema["instance_tenancy"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return dvpc
+}
+
+func resourceAwsDefaultVpcCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	req := &ec2.DescribeVpcsInput{
+		Filters: buildEC2AttributeFilterList(
+			map[string]string{
+				"isDefault": "true",
+			},
+		),
+	}
+
+	log.Printf("[DEBUG] Commandeer Default VPC: %s", req)
+	resp, err := conn.DescribeVpcs(req)
+	if err != nil {
+		return fmt.Errorf("Error reading Default VPC: %s", err)
+	}
+	if len(resp.Vpcs) != 1 {
+		return fmt.Errorf("[ERR] Error finding default VPC in region %s; found (%d) VPCs",
+			meta.(*AWSClient).region, len(resp.Vpcs))
+	}
+
+	d.SetId(aws.StringValue(resp.Vpcs[0].VpcId))
+
+	log.Printf("[INFO] Default VPC ID: %s", d.Id())
+
+	return resourceAwsVpcUpdate(d, meta)
+}
+
+func resourceAwsDefaultVpcDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Cannot destroy Default VPC. Terraform will remove this resource from the state file, however resources may remain.")
+	d.SetId("")
+	return nil
+}
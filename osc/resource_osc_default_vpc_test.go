@@ -0,0 +1,47 @@
+package osc
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDefaultVpc_basic(t *testing.T) {
+	var vpc ec2.Vpc
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDefaultVpcDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDefaultVpcConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_default_vpc.foo", &vpc),
+					resource.TestCheckResourceAttr("aws_default_vpc.foo", "cidr_block", "172.31.0.0/16"),
+					resource.TestCheckResourceAttr("aws_default_vpc.foo", "enable_dns_hostnames", "true"),
+					resource.TestCheckResourceAttr("aws_default_vpc.foo", "tags.Name", "Default VPC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDefaultVpcDestroy(s *terraform.State) error {
+	// We expect the default VPC to still exist
+	return nil
+}
+
+const testAccAWSDefaultVpcConfig = `
+provider "aws" {
+    region = "us-west-2"
+}
+
+resource "aws_default_vpc" "foo" {
+	tags {
+		Name = "Default VPC"
+	}
+}
+`
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,10 +19,37 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 		Read:   resourceAwsEbsSnapshotRead,
 		Delete: resourceAwsEbsSnapshotDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"volume_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"file_location"},
+			},
+			// The following attributes create the snapshot by importing a
+			// disk image stored in OSU instead of snapshotting a volume.
+			"file_location": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"volume_id"},
+				ValidateFunc:  validateOsuLocation,
+			},
+			"presigned_url": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"snapshot_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"description": {
@@ -65,23 +93,34 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	request := &ec2.CreateSnapshotInput{
-		VolumeId: aws.String(d.Get("volume_id").(string)),
-	}
-	if v, ok := d.GetOk("description"); ok {
-		request.Description = aws.String(v.(string))
-	}
+	if _, ok := d.GetOk("file_location"); ok {
+		if err := resourceAwsEbsSnapshotImport(d, meta); err != nil {
+			return err
+		}
+	} else {
+		volumeId, ok := d.GetOk("volume_id")
+		if !ok {
+			return fmt.Errorf("One of volume_id or file_location must be set")
+		}
 
-	res, err := conn.CreateSnapshot(request)
-	if err != nil {
-		return err
-	}
+		request := &ec2.CreateSnapshotInput{
+			VolumeId: aws.String(volumeId.(string)),
+		}
+		if v, ok := d.GetOk("description"); ok {
+			request.Description = aws.String(v.(string))
+		}
 
-	d.SetId(*res.SnapshotId)
+		res, err := conn.CreateSnapshot(request)
+		if err != nil {
+			return err
+		}
 
-	err = resourceAwsEbsSnapshotWaitForAvailable(d.Id(), conn)
-	if err != nil {
-		return err
+		d.SetId(*res.SnapshotId)
+
+		err = resourceAwsEbsSnapshotWaitForAvailable(d.Id(), conn)
+		if err != nil {
+			return err
+		}
 	}
 
 	if err := setTags(conn, d); err != nil {
@@ -103,6 +142,14 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		return err
+	}
+	if len(res.Snapshots) == 0 {
+		log.Printf("Snapshot %q Not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 
 	snapshot := res.Snapshots[0]
 
@@ -114,6 +161,11 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("data_encryption_key_id", snapshot.DataEncryptionKeyId)
 	d.Set("kms_keey_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
+	if _, ok := d.GetOk("file_location"); ok {
+		// Imported snapshots aren't backed by a volume of ours; FCU reports a
+		// placeholder that we don't want to surface.
+		d.Set("volume_id", "")
+	}
 
 	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
@@ -156,3 +208,118 @@ func resourceAwsEbsSnapshotWaitForAvailable(id string, conn *ec2.EC2) error {
 	err := conn.WaitUntilSnapshotCompleted(req)
 	return err
 }
+
+// osuImportSnapshotInput is the input of the FCU ImportSnapshot call, which
+// differs from the EC2 one: FCU takes the pre-signed URL and the exact size of
+// the disk image instead of a disk container.
+type osuImportSnapshotInput struct {
+	_ struct{} `type:"structure"`
+
+	Description      *string `locationName:"description" type:"string"`
+	SnapshotLocation *string `locationName:"snapshotLocation" type:"string"`
+	SnapshotSize     *int64  `locationName:"snapshotSize" type:"long"`
+}
+
+type osuImportSnapshotOutput struct {
+	_ struct{} `type:"structure"`
+
+	Description *string `locationName:"description" type:"string"`
+	SnapshotId  *string `locationName:"snapshotId" type:"string"`
+}
+
+func osuImportSnapshot(conn *ec2.EC2, input *osuImportSnapshotInput) (*osuImportSnapshotOutput, error) {
+	op := &request.Operation{
+		Name:       "ImportSnapshot",
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	output := &osuImportSnapshotOutput{}
+	req := conn.NewRequest(op, input, output)
+	return output, req.Send()
+}
+
+func resourceAwsEbsSnapshotImport(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	s3conn := meta.(*AWSClient).s3conn
+	location := d.Get("file_location").(string)
+
+	input := &osuImportSnapshotInput{}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("presigned_url"); ok {
+		input.SnapshotLocation = aws.String(v.(string))
+	} else {
+		url, err := osuPresignedURL(s3conn, location)
+		if err != nil {
+			return err
+		}
+		input.SnapshotLocation = aws.String(url)
+	}
+
+	if v, ok := d.GetOk("snapshot_size"); ok {
+		input.SnapshotSize = aws.Int64(int64(v.(int)))
+	} else {
+		size, err := osuObjectSize(s3conn, location)
+		if err != nil {
+			return fmt.Errorf("%s; set snapshot_size if the object can't be read with the provider credentials", err)
+		}
+		input.SnapshotSize = aws.Int64(size)
+		d.Set("snapshot_size", int(size))
+	}
+
+	log.Printf("[DEBUG] Importing snapshot from %s (%d bytes)", location, *input.SnapshotSize)
+	res, err := osuImportSnapshot(conn, input)
+	if err != nil {
+		return fmt.Errorf("Error importing snapshot from %s: %s", location, err)
+	}
+	if res.SnapshotId == nil || *res.SnapshotId == "" {
+		return fmt.Errorf("Error importing snapshot from %s: no snapshot ID returned", location)
+	}
+
+	d.SetId(*res.SnapshotId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "importing"},
+		Target:     []string{"completed"},
+		Refresh:    resourceAwsEbsSnapshotStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for snapshot (%s) import from %s: %s", d.Id(), location, err)
+	}
+
+	return nil
+}
+
+func resourceAwsEbsSnapshotStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(id)},
+		})
+		if err != nil {
+			if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidSnapshotID.NotFound" {
+				// The snapshot may not be visible right after the import call.
+				return nil, "pending", nil
+			}
+			return nil, "", err
+		}
+
+		if len(res.Snapshots) == 0 {
+			return nil, "pending", nil
+		}
+
+		snapshot := res.Snapshots[0]
+		state := aws.StringValue(snapshot.State)
+		if state == "error" {
+			return snapshot, state, fmt.Errorf("snapshot %s failed: %s", id, aws.StringValue(snapshot.StateMessage))
+		}
+
+		return snapshot, state, nil
+	}
+}
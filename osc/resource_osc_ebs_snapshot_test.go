@@ -2,6 +2,7 @@ package osc
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -43,6 +44,31 @@ func TestAccAWSEBSSnapshot_withDescription(t *testing.T) {
 	})
 }
 
+func TestAccAWSEBSSnapshot_fileLocation(t *testing.T) {
+	var v ec2.Snapshot
+	location := os.Getenv("OSC_SNAPSHOT_FILE_LOCATION")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if location == "" {
+				t.Fatal("OSC_SNAPSHOT_FILE_LOCATION must be set to the bucket/key of a disk image in OSU")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotConfigFileLocation(location),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot.test", &v),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot.test", "file_location", location),
+					resource.TestCheckResourceAttrSet("aws_ebs_snapshot.test", "snapshot_size"),
+					resource.TestCheckResourceAttrSet("aws_ebs_snapshot.test", "volume_size"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSnapshotExists(n string, v *ec2.Snapshot) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -93,3 +119,12 @@ resource "aws_ebs_snapshot" "test" {
 	description = "EBS Snapshot Acceptance Test"
 }
 `
+
+func testAccAwsEbsSnapshotConfigFileLocation(location string) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_snapshot" "test" {
+	file_location = "%s"
+	description = "EBS Snapshot import Acceptance Test"
+}
+`, location)
+}
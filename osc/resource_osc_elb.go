@@ -2,6 +2,7 @@ package osc
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -50,6 +52,26 @@ func resourceAwsElb() *schema.Resource {
 				Default:  true,
 			},
 
+			"idle_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validateIntegerInRange(1, 4000),
+			},
+
+			"connection_draining": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"connection_draining_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validateIntegerInRange(1, 3600),
+			},
+
 			"availability_zones": &schema.Schema{
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -69,7 +91,6 @@ func resourceAwsElb() *schema.Resource {
 			"security_groups": &schema.Schema{
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
-				ForceNew: true,
 				Optional: true,
 				Computed: true,
 				Set:      schema.HashString,
@@ -89,7 +110,6 @@ func resourceAwsElb() *schema.Resource {
 			"subnets": &schema.Schema{
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
-				ForceNew: true,
 				Optional: true,
 				Computed: true,
 				Set:      schema.HashString,
@@ -227,6 +247,12 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// Check the access logs bucket before creating anything, logs are only
+	// enabled once the load balancer exists.
+	if err := validateElbAccessLogsBucket(d, meta); err != nil {
+		return err
+	}
+
 	var elbName string
 	if v, ok := d.GetOk("name"); ok {
 		elbName = v.(string)
@@ -372,10 +398,16 @@ func resourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 	d.Set("subnets", flattenStringList(lb.Subnets))
-	//d.Set("idle_timeout", lbAttrs.ConnectionSettings.IdleTimeout)
-	//d.Set("connection_draining", lbAttrs.ConnectionDraining.Enabled)
-	//d.Set("connection_draining_timeout", lbAttrs.ConnectionDraining.Timeout)
-	//d.Set("cross_zone_load_balancing", lbAttrs.CrossZoneLoadBalancing.Enabled)
+	if lbAttrs.ConnectionSettings != nil {
+		d.Set("idle_timeout", lbAttrs.ConnectionSettings.IdleTimeout)
+	}
+	if lbAttrs.ConnectionDraining != nil {
+		d.Set("connection_draining", lbAttrs.ConnectionDraining.Enabled)
+		d.Set("connection_draining_timeout", lbAttrs.ConnectionDraining.Timeout)
+	}
+	if lbAttrs.CrossZoneLoadBalancing != nil {
+		d.Set("cross_zone_load_balancing", lbAttrs.CrossZoneLoadBalancing.Enabled)
+	}
 	if lbAttrs.AccessLog != nil {
 		// The AWS API does not allow users to remove access_logs, only disable them.
 		// During creation of the ELB, Terraform sets the access_logs to disabled,
@@ -433,6 +465,34 @@ func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
 		remove, _ := expandListeners(os.Difference(ns).List())
 		add, _ := expandListeners(ns.Difference(os).List())
 
+		// Listeners whose only change is the certificate are updated in
+		// place, so HTTPS traffic keeps flowing while certificates rotate.
+		certUpdates, remove, add := elbListenerCertificateChanges(remove, add)
+		for _, listener := range certUpdates {
+			setCertOpts := &elb.SetLoadBalancerListenerSSLCertificateInput{
+				LoadBalancerName: aws.String(d.Id()),
+				LoadBalancerPort: listener.LoadBalancerPort,
+				SSLCertificateId: listener.SSLCertificateId,
+			}
+
+			err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+				log.Printf("[DEBUG] ELB Set Listener SSL Certificate opts: %s", setCertOpts)
+				if _, err := elbconn.SetLoadBalancerListenerSSLCertificate(setCertOpts); err != nil {
+					if awsErr, ok := err.(awserr.Error); ok {
+						if awsErr.Code() == "CertificateNotFound" && strings.Contains(awsErr.Message(), "Server Certificate not found for the key: arn") {
+							log.Printf("[DEBUG] SSL Cert not found for given ARN, retrying")
+							return resource.RetryableError(awsErr)
+						}
+					}
+					return resource.NonRetryableError(err)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("Failure updating ELB listener certificate on port %d: %s", *listener.LoadBalancerPort, err)
+			}
+		}
+
 		if len(remove) > 0 {
 			ports := make([]*int64, 0, len(remove))
 			for _, listener := range remove {
@@ -523,35 +583,75 @@ func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("instances")
 	}
 
-	if d.HasChange("access_logs") {
-		logs := d.Get("access_logs").([]interface{})
-		if len(logs) == 1 {
-			attrs := elb.ModifyLoadBalancerAttributesInput{
-				LoadBalancerName: aws.String(d.Get("name").(string)),
+	if d.HasChange("access_logs") || d.HasChange("cross_zone_load_balancing") ||
+		d.HasChange("idle_timeout") || d.HasChange("connection_draining") ||
+		d.HasChange("connection_draining_timeout") {
+		attrs := elb.ModifyLoadBalancerAttributesInput{
+			LoadBalancerName:       aws.String(d.Get("name").(string)),
+			LoadBalancerAttributes: &elb.LoadBalancerAttributes{},
+		}
+
+		if d.HasChange("access_logs") {
+			if !d.IsNewResource() {
+				if err := validateElbAccessLogsBucket(d, meta); err != nil {
+					return err
+				}
+			}
+
+			logs := d.Get("access_logs").([]interface{})
+			if len(logs) == 1 {
+				l := logs[0].(map[string]interface{})
+				accessLog := &elb.AccessLog{
+					Enabled:      aws.Bool(l["enabled"].(bool)),
+					EmitInterval: aws.Int64(int64(l["interval"].(int))),
+					S3BucketName: aws.String(l["bucket"].(string)),
+				}
+
+				if l["bucket_prefix"] != "" {
+					accessLog.S3BucketPrefix = aws.String(l["bucket_prefix"].(string))
+				}
+
+				attrs.LoadBalancerAttributes.AccessLog = accessLog
+			} else if len(logs) == 0 {
+				// disable access logs
+				attrs.LoadBalancerAttributes.AccessLog = &elb.AccessLog{
+					Enabled: aws.Bool(false),
+				}
 			}
-			l := logs[0].(map[string]interface{})
-			accessLog := &elb.AccessLog{
-				Enabled:      aws.Bool(l["enabled"].(bool)),
-				EmitInterval: aws.Int64(int64(l["interval"].(int))),
-				S3BucketName: aws.String(l["bucket"].(string)),
+		}
+
+		if d.HasChange("cross_zone_load_balancing") {
+			attrs.LoadBalancerAttributes.CrossZoneLoadBalancing = &elb.CrossZoneLoadBalancing{
+				Enabled: aws.Bool(d.Get("cross_zone_load_balancing").(bool)),
 			}
+		}
 
-			if l["bucket_prefix"] != "" {
-				accessLog.S3BucketPrefix = aws.String(l["bucket_prefix"].(string))
+		if d.HasChange("idle_timeout") {
+			attrs.LoadBalancerAttributes.ConnectionSettings = &elb.ConnectionSettings{
+				IdleTimeout: aws.Int64(int64(d.Get("idle_timeout").(int))),
 			}
+		}
 
-			attrs.LoadBalancerAttributes.AccessLog = accessLog
-			log.Printf("[DEBUG] ELB Modify Load Balancer Attributes Request: %#v", attrs)
-			_, err := elbconn.ModifyLoadBalancerAttributes(&attrs)
-			if err != nil {
-				return fmt.Errorf("Failure configuring ELB attributes: %s", err)
+		// The timeout is only accepted by the API alongside the enabled flag,
+		// so both are sent whenever either of them changes.
+		if d.HasChange("connection_draining") || d.HasChange("connection_draining_timeout") {
+			attrs.LoadBalancerAttributes.ConnectionDraining = &elb.ConnectionDraining{
+				Enabled: aws.Bool(d.Get("connection_draining").(bool)),
+				Timeout: aws.Int64(int64(d.Get("connection_draining_timeout").(int))),
 			}
-		} else if len(logs) == 0 {
-			// disable access logs
-			//attrs.LoadBalancerAttributes.AccessLog = &elb.AccessLog{
-			//	Enabled: aws.Bool(false),
-			//}
 		}
+
+		log.Printf("[DEBUG] ELB Modify Load Balancer Attributes Request: %#v", attrs)
+		_, err := elbconn.ModifyLoadBalancerAttributes(&attrs)
+		if err != nil {
+			return fmt.Errorf("Failure configuring ELB attributes: %s", err)
+		}
+
+		d.SetPartial("access_logs")
+		d.SetPartial("cross_zone_load_balancing")
+		d.SetPartial("idle_timeout")
+		d.SetPartial("connection_draining")
+		d.SetPartial("connection_draining_timeout")
 	}
 
 	if d.HasChange("health_check") {
@@ -576,109 +676,109 @@ func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	//if d.HasChange("security_groups") {
-	//	groups := d.Get("security_groups").(*schema.Set).List()
-	//
-	//	applySecurityGroupsOpts := elb.ApplySecurityGroupsToLoadBalancerInput{
-	//		LoadBalancerName: aws.String(d.Id()),
-	//		SecurityGroups:   expandStringList(groups),
-	//	}
-	//
-	//	_, err := elbconn.ApplySecurityGroupsToLoadBalancer(&applySecurityGroupsOpts)
-	//	if err != nil {
-	//		return fmt.Errorf("Failure applying security groups to ELB: %s", err)
-	//	}
-	//
-	//	d.SetPartial("security_groups")
-	//}
-
-	//if d.HasChange("availability_zones") {
-	//	o, n := d.GetChange("availability_zones")
-	//	os := o.(*schema.Set)
-	//	ns := n.(*schema.Set)
-	//
-	//	removed := expandStringList(os.Difference(ns).List())
-	//	added := expandStringList(ns.Difference(os).List())
-	//
-	//	if len(added) > 0 {
-	//		enableOpts := &elb.EnableAvailabilityZonesForLoadBalancerInput{
-	//			LoadBalancerName:  aws.String(d.Id()),
-	//			AvailabilityZones: added,
-	//		}
-	//
-	//		log.Printf("[DEBUG] ELB enable availability zones opts: %s", enableOpts)
-	//		_, err := elbconn.EnableAvailabilityZonesForLoadBalancer(enableOpts)
-	//		if err != nil {
-	//			return fmt.Errorf("Failure enabling ELB availability zones: %s", err)
-	//		}
-	//	}
-	//
-	//	if len(removed) > 0 {
-	//		disableOpts := &elb.DisableAvailabilityZonesForLoadBalancerInput{
-	//			LoadBalancerName:  aws.String(d.Id()),
-	//			AvailabilityZones: removed,
-	//		}
-	//
-	//		log.Printf("[DEBUG] ELB disable availability zones opts: %s", disableOpts)
-	//		_, err := elbconn.DisableAvailabilityZonesForLoadBalancer(disableOpts)
-	//		if err != nil {
-	//			return fmt.Errorf("Failure disabling ELB availability zones: %s", err)
-	//		}
-	//	}
-	//
-	//	d.SetPartial("availability_zones")
-	//}
-
-	//if d.HasChange("subnets") {
-	//	o, n := d.GetChange("subnets")
-	//	os := o.(*schema.Set)
-	//	ns := n.(*schema.Set)
-	//
-	//	removed := expandStringList(os.Difference(ns).List())
-	//	added := expandStringList(ns.Difference(os).List())
-	//
-	//	if len(removed) > 0 {
-	//		detachOpts := &elb.DetachLoadBalancerFromSubnetsInput{
-	//			LoadBalancerName: aws.String(d.Id()),
-	//			Subnets:          removed,
-	//		}
-	//
-	//		log.Printf("[DEBUG] ELB detach subnets opts: %s", detachOpts)
-	//		_, err := elbconn.DetachLoadBalancerFromSubnets(detachOpts)
-	//		if err != nil {
-	//			return fmt.Errorf("Failure removing ELB subnets: %s", err)
-	//		}
-	//	}
-	//
-	//	if len(added) > 0 {
-	//		attachOpts := &elb.AttachLoadBalancerToSubnetsInput{
-	//			LoadBalancerName: aws.String(d.Id()),
-	//			Subnets:          added,
-	//		}
-	//
-	//		log.Printf("[DEBUG] ELB attach subnets opts: %s", attachOpts)
-	//		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-	//			_, err := elbconn.AttachLoadBalancerToSubnets(attachOpts)
-	//			if err != nil {
-	//				if awsErr, ok := err.(awserr.Error); ok {
-	//					// eventually consistent issue with removing a subnet in AZ1 and
-	//					// immediately adding a new one in the same AZ
-	//					if awsErr.Code() == "InvalidConfigurationRequest" && strings.Contains(awsErr.Message(), "cannot be attached to multiple subnets in the same AZ") {
-	//						log.Printf("[DEBUG] retrying az association")
-	//						return resource.RetryableError(awsErr)
-	//					}
-	//				}
-	//				return resource.NonRetryableError(err)
-	//			}
-	//			return nil
-	//		})
-	//		if err != nil {
-	//			return fmt.Errorf("Failure adding ELB subnets: %s", err)
-	//		}
-	//	}
-	//
-	//	d.SetPartial("subnets")
-	//}
+	if d.HasChange("security_groups") && !d.IsNewResource() {
+		groups := d.Get("security_groups").(*schema.Set).List()
+
+		applySecurityGroupsOpts := elb.ApplySecurityGroupsToLoadBalancerInput{
+			LoadBalancerName: aws.String(d.Id()),
+			SecurityGroups:   expandStringList(groups),
+		}
+
+		_, err := elbconn.ApplySecurityGroupsToLoadBalancer(&applySecurityGroupsOpts)
+		if err != nil {
+			return fmt.Errorf("Failure applying security groups to ELB: %s", err)
+		}
+
+		d.SetPartial("security_groups")
+	}
+
+	if d.HasChange("availability_zones") && !d.IsNewResource() {
+		o, n := d.GetChange("availability_zones")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		removed := expandStringList(os.Difference(ns).List())
+		added := expandStringList(ns.Difference(os).List())
+
+		if len(added) > 0 {
+			enableOpts := &elb.EnableAvailabilityZonesForLoadBalancerInput{
+				LoadBalancerName:  aws.String(d.Id()),
+				AvailabilityZones: added,
+			}
+
+			log.Printf("[DEBUG] ELB enable availability zones opts: %s", enableOpts)
+			_, err := elbconn.EnableAvailabilityZonesForLoadBalancer(enableOpts)
+			if err != nil {
+				return fmt.Errorf("Failure enabling ELB availability zones: %s", err)
+			}
+		}
+
+		if len(removed) > 0 {
+			disableOpts := &elb.DisableAvailabilityZonesForLoadBalancerInput{
+				LoadBalancerName:  aws.String(d.Id()),
+				AvailabilityZones: removed,
+			}
+
+			log.Printf("[DEBUG] ELB disable availability zones opts: %s", disableOpts)
+			_, err := elbconn.DisableAvailabilityZonesForLoadBalancer(disableOpts)
+			if err != nil {
+				return fmt.Errorf("Failure disabling ELB availability zones: %s", err)
+			}
+		}
+
+		d.SetPartial("availability_zones")
+	}
+
+	if d.HasChange("subnets") && !d.IsNewResource() {
+		o, n := d.GetChange("subnets")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		removed := expandStringList(os.Difference(ns).List())
+		added := expandStringList(ns.Difference(os).List())
+
+		if len(removed) > 0 {
+			detachOpts := &elb.DetachLoadBalancerFromSubnetsInput{
+				LoadBalancerName: aws.String(d.Id()),
+				Subnets:          removed,
+			}
+
+			log.Printf("[DEBUG] ELB detach subnets opts: %s", detachOpts)
+			_, err := elbconn.DetachLoadBalancerFromSubnets(detachOpts)
+			if err != nil {
+				return fmt.Errorf("Failure removing ELB subnets: %s", err)
+			}
+		}
+
+		if len(added) > 0 {
+			attachOpts := &elb.AttachLoadBalancerToSubnetsInput{
+				LoadBalancerName: aws.String(d.Id()),
+				Subnets:          added,
+			}
+
+			log.Printf("[DEBUG] ELB attach subnets opts: %s", attachOpts)
+			err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+				_, err := elbconn.AttachLoadBalancerToSubnets(attachOpts)
+				if err != nil {
+					if awsErr, ok := err.(awserr.Error); ok {
+						// eventually consistent issue with removing a subnet in AZ1 and
+						// immediately adding a new one in the same AZ
+						if awsErr.Code() == "InvalidConfigurationRequest" && strings.Contains(awsErr.Message(), "cannot be attached to multiple subnets in the same AZ") {
+							log.Printf("[DEBUG] retrying az association")
+							return resource.RetryableError(awsErr)
+						}
+					}
+					return resource.NonRetryableError(err)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("Failure adding ELB subnets: %s", err)
+			}
+		}
+
+		d.SetPartial("subnets")
+	}
 
 	if err := setTagsELB(elbconn, d); err != nil {
 		return err
@@ -723,6 +823,266 @@ func resourceAwsElbListenerHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+// elbListenerCertificateChanges splits out of the removed and added
+// listeners those that only differ by their SSL certificate. It returns
+// the new definition of these listeners along with the remaining listeners
+// that still have to be deleted and created.
+func elbListenerCertificateChanges(remove, add []*elb.Listener) ([]*elb.Listener, []*elb.Listener, []*elb.Listener) {
+	var certUpdates, remainingAdd []*elb.Listener
+	replaced := make(map[int]bool)
+
+	for _, n := range add {
+		matched := false
+		if aws.StringValue(n.SSLCertificateId) != "" {
+			for i, o := range remove {
+				if replaced[i] {
+					continue
+				}
+				if aws.Int64Value(o.LoadBalancerPort) == aws.Int64Value(n.LoadBalancerPort) &&
+					aws.Int64Value(o.InstancePort) == aws.Int64Value(n.InstancePort) &&
+					strings.EqualFold(aws.StringValue(o.Protocol), aws.StringValue(n.Protocol)) &&
+					strings.EqualFold(aws.StringValue(o.InstanceProtocol), aws.StringValue(n.InstanceProtocol)) &&
+					aws.StringValue(o.SSLCertificateId) != "" {
+					replaced[i] = true
+					matched = true
+					break
+				}
+			}
+		}
+
+		if matched {
+			certUpdates = append(certUpdates, n)
+		} else {
+			remainingAdd = append(remainingAdd, n)
+		}
+	}
+
+	var remainingRemove []*elb.Listener
+	for i, o := range remove {
+		if !replaced[i] {
+			remainingRemove = append(remainingRemove, o)
+		}
+	}
+
+	return certUpdates, remainingRemove, remainingAdd
+}
+
+// validateElbAccessLogsBucket checks that the bucket configured in enabled
+// access_logs exists and that the logs can be written to it. When the LBU
+// service account of the region is known, the bucket policy must allow it
+// to put objects under the prefix. Otherwise a probe object is written and
+// deleted, which at least catches a bucket the caller can't write to.
+func validateElbAccessLogsBucket(d *schema.ResourceData, meta interface{}) error {
+	logs := d.Get("access_logs").([]interface{})
+	if len(logs) != 1 {
+		return nil
+	}
+	l := logs[0].(map[string]interface{})
+	if !l["enabled"].(bool) {
+		return nil
+	}
+
+	client := meta.(*AWSClient)
+	s3conn := client.s3conn
+	bucket := l["bucket"].(string)
+	prefix := strings.Trim(l["bucket_prefix"].(string), "/")
+
+	_, err := s3conn.HeadBucket(&s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 {
+			return fmt.Errorf("ELB access logs bucket %q does not exist", bucket)
+		}
+		return fmt.Errorf("Error checking ELB access logs bucket %q: %s", bucket, err)
+	}
+
+	accid := LBUServiceAccountIdForRegion(client.region)
+	if accid == "" {
+		return probeElbAccessLogsBucket(s3conn, bucket, prefix)
+	}
+
+	resp, err := s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if isAWSErr(err, "NoSuchBucketPolicy", "") {
+			return fmt.Errorf("ELB access logs bucket %q has no policy allowing account %s to deliver logs", bucket, accid)
+		}
+		return fmt.Errorf("Error reading ELB access logs bucket %q policy: %s", bucket, err)
+	}
+
+	ok, err := elbAccessLogsPolicyAllows(aws.StringValue(resp.Policy), client.partition, accid, bucket, prefix, client.accountid)
+	if err != nil {
+		return fmt.Errorf("Error parsing ELB access logs bucket %q policy: %s", bucket, err)
+	}
+	if !ok {
+		return fmt.Errorf("ELB access logs bucket %q policy does not allow account %s to put objects under %q", bucket, accid, prefix)
+	}
+
+	return nil
+}
+
+// probeElbAccessLogsBucket writes and deletes an empty object under the
+// access logs prefix.
+func probeElbAccessLogsBucket(conn *s3.S3, bucket, prefix string) error {
+	key := resource.PrefixedUniqueId("tf-elb-access-logs-probe-")
+	if prefix != "" {
+		key = prefix + "/" + key
+	}
+
+	log.Printf("[DEBUG] Writing ELB access logs probe object %s to bucket %s", key, bucket)
+	_, err := conn.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader([]byte{}),
+	})
+	if err != nil {
+		return fmt.Errorf("ELB access logs bucket %q is not writable: %s", bucket, err)
+	}
+
+	_, err = conn.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting ELB access logs probe object %q from bucket %q: %s", key, bucket, err)
+	}
+
+	return nil
+}
+
+// elbAccessLogsPolicyStatement is a statement of a bucket policy. Action,
+// Resource and the principals may be given as a single string or a list.
+type elbAccessLogsPolicyStatement struct {
+	Effect    string
+	Action    interface{}
+	Resource  interface{}
+	Principal interface{}
+}
+
+// elbAccessLogsPolicyAllows reports whether a bucket policy lets the given
+// account put the log objects of a load balancer under the prefix: an
+// Allow statement must cover it and no Deny statement may. Conditions
+// aren't evaluated.
+func elbAccessLogsPolicyAllows(policy, partition, serviceAccountId, bucket, prefix, accountId string) (bool, error) {
+	var doc struct {
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, err
+	}
+
+	var stmts []elbAccessLogsPolicyStatement
+	if err := json.Unmarshal(doc.Statement, &stmts); err != nil {
+		var stmt elbAccessLogsPolicyStatement
+		if err := json.Unmarshal(doc.Statement, &stmt); err != nil {
+			return false, err
+		}
+		stmts = []elbAccessLogsPolicyStatement{stmt}
+	}
+
+	// Logs are delivered below AWSLogs/<account id>/ in the prefix.
+	objectPrefix := fmt.Sprintf("arn:%s:s3:::%s/", partition, bucket)
+	if prefix != "" {
+		objectPrefix = objectPrefix + prefix + "/"
+	}
+	objectPrefix = objectPrefix + "AWSLogs/"
+	object := objectPrefix + accountId + "/elasticloadbalancing/log"
+
+	principals := []string{
+		"*",
+		serviceAccountId,
+		fmt.Sprintf("arn:%s:iam::%s:root", partition, serviceAccountId),
+	}
+
+	allowed := false
+	for _, stmt := range stmts {
+		if !elbAccessLogsPolicyMatchesPrincipal(stmt.Principal, principals) ||
+			!elbAccessLogsPolicyMatches(stmt.Action, "s3:PutObject", true) {
+			continue
+		}
+
+		covered := elbAccessLogsPolicyMatches(stmt.Resource, object, false)
+		if !covered && accountId == "" {
+			// The account of the load balancer isn't known, accept a
+			// grant restricted to any account below the prefix.
+			for _, r := range elbAccessLogsPolicyValues(stmt.Resource) {
+				if strings.HasPrefix(r, objectPrefix) {
+					covered = true
+				}
+			}
+		}
+		if !covered {
+			continue
+		}
+
+		switch stmt.Effect {
+		case "Deny":
+			return false, nil
+		case "Allow":
+			allowed = true
+		}
+	}
+
+	return allowed, nil
+}
+
+// elbAccessLogsPolicyMatchesPrincipal reports whether the principal of a
+// statement names one of the given principals.
+func elbAccessLogsPolicyMatchesPrincipal(principal interface{}, principals []string) bool {
+	if p, ok := principal.(string); ok {
+		return p == "*"
+	}
+	m, ok := principal.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for _, v := range elbAccessLogsPolicyValues(m["AWS"]) {
+		for _, p := range principals {
+			if v == p {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// elbAccessLogsPolicyMatches reports whether one of the patterns of a
+// statement element, where * and ? are wildcards, matches value.
+func elbAccessLogsPolicyMatches(patterns interface{}, value string, ignoreCase bool) bool {
+	for _, p := range elbAccessLogsPolicyValues(patterns) {
+		expr := regexp.QuoteMeta(p)
+		expr = strings.Replace(expr, `\*`, ".*", -1)
+		expr = strings.Replace(expr, `\?`, ".", -1)
+		if ignoreCase {
+			expr = "(?i)" + expr
+		}
+		if regexp.MustCompile("^" + expr + "$").MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// elbAccessLogsPolicyValues returns the values of a statement element given
+// as a single string or a list of strings.
+func elbAccessLogsPolicyValues(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
 func isLoadBalancerNotFound(err error) bool {
 	elberr, ok := err.(awserr.Error)
 	return ok && elberr.Code() == "LoadBalancerNotFound"
@@ -3,10 +3,10 @@ package osc
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elb"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -15,6 +15,9 @@ func resourceAwsElbAttachment() *schema.Resource {
 		Create: resourceAwsElbAttachmentCreate,
 		Read:   resourceAwsElbAttachmentRead,
 		Delete: resourceAwsElbAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElbAttachmentImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"elb": &schema.Schema{
@@ -50,7 +53,7 @@ func resourceAwsElbAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Failure registering instances with ELB: %s", err)
 	}
 
-	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", elbName)))
+	d.SetId(elbAttachmentID(elbName, instance))
 
 	return nil
 }
@@ -119,3 +122,19 @@ func resourceAwsElbAttachmentDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+func resourceAwsElbAttachmentImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ELBNAME/INSTANCEID", d.Id())
+	}
+
+	d.Set("elb", parts[0])
+	d.Set("instance", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func elbAttachmentID(elbName, instance string) string {
+	return fmt.Sprintf("%s/%s", elbName, instance)
+}
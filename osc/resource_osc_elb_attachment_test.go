@@ -44,6 +44,12 @@ func TestAccAWSELBAttachment_basic(t *testing.T) {
 				),
 			},
 
+			resource.TestStep{
+				ResourceName:      "aws_elb_attachment.foo1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+
 			resource.TestStep{
 				Config: testAccAWSELBAttachmentConfig3,
 				Check: resource.ComposeTestCheckFunc(
@@ -63,6 +69,28 @@ func TestAccAWSELBAttachment_basic(t *testing.T) {
 	})
 }
 
+func TestResourceAwsElbAttachmentImportState(t *testing.T) {
+	d := resourceAwsElbAttachment().Data(nil)
+	d.SetId("my-elb/i-12345678")
+	if _, err := resourceAwsElbAttachmentImportState(d, nil); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if v := d.Get("elb").(string); v != "my-elb" {
+		t.Fatalf("Expected elb my-elb, got %s", v)
+	}
+	if v := d.Get("instance").(string); v != "i-12345678" {
+		t.Fatalf("Expected instance i-12345678, got %s", v)
+	}
+
+	for _, id := range []string{"my-elb", "my-elb/", "/i-12345678", "my-elb/i-12345678/extra"} {
+		d := resourceAwsElbAttachment().Data(nil)
+		d.SetId(id)
+		if _, err := resourceAwsElbAttachmentImportState(d, nil); err == nil {
+			t.Errorf("Expected an error for %q", id)
+		}
+	}
+}
+
 // remove and instance and check that it's correctly re-attached.
 func TestAccAWSELBAttachment_drift(t *testing.T) {
 	var conf elb.LoadBalancerDescription
@@ -0,0 +1,285 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Maximum number of instances sent in a single Register or Deregister call.
+const elbBackendsBatchSize = 20
+
+// resourceAwsElbBackends authoritatively manages the whole set of instances
+// registered with a load balancer. It must not be combined with
+// osc_elb_attachment or the instances argument of osc_elb for the same ELB.
+func resourceAwsElbBackends() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsElbBackendsCreate,
+		Read:   resourceAwsElbBackendsRead,
+		Update: resourceAwsElbBackendsUpdate,
+		Delete: resourceAwsElbBackendsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElbBackendsImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"elb": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"instances": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"wait_for_in_service": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsElbBackendsCreate(d *schema.ResourceData, meta interface{}) error {
+	elbconn := meta.(*AWSClient).elbconn
+	elbName := d.Get("elb").(string)
+
+	// Start from the instances actually registered, so that the ones
+	// registered outside of this resource are removed on the first apply.
+	resp, err := elbconn.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
+		LoadBalancerNames: []*string{aws.String(elbName)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving ELB %s: %s", elbName, err)
+	}
+	if len(resp.LoadBalancerDescriptions) != 1 {
+		return fmt.Errorf("Unable to find ELB: %s", elbName)
+	}
+
+	current := schema.NewSet(schema.HashString, nil)
+	for _, id := range flattenInstances(resp.LoadBalancerDescriptions[0].Instances) {
+		current.Add(id)
+	}
+	wanted := d.Get("instances").(*schema.Set)
+
+	removed := expandStringList(current.Difference(wanted).List())
+	added := expandStringList(wanted.Difference(current).List())
+
+	if err := deregisterElbBackends(elbconn, elbName, removed); err != nil {
+		return err
+	}
+	if err := registerElbBackends(elbconn, elbName, added); err != nil {
+		return err
+	}
+
+	d.SetId(elbName)
+
+	if d.Get("wait_for_in_service").(bool) {
+		instances := expandStringList(wanted.List())
+		if err := waitForElbBackendsInService(elbconn, elbName, instances, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsElbBackendsRead(d, meta)
+}
+
+func resourceAwsElbBackendsRead(d *schema.ResourceData, meta interface{}) error {
+	elbconn := meta.(*AWSClient).elbconn
+
+	resp, err := elbconn.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
+		LoadBalancerNames: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isLoadBalancerNotFound(err) {
+			log.Printf("[WARN] ELB %s not found, removing backends from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving ELB: %s", err)
+	}
+	if len(resp.LoadBalancerDescriptions) != 1 {
+		log.Printf("[WARN] Unable to find ELB %s, removing backends from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("elb", resp.LoadBalancerDescriptions[0].LoadBalancerName)
+	d.Set("instances", flattenInstances(resp.LoadBalancerDescriptions[0].Instances))
+
+	return nil
+}
+
+func resourceAwsElbBackendsUpdate(d *schema.ResourceData, meta interface{}) error {
+	elbconn := meta.(*AWSClient).elbconn
+
+	if d.HasChange("instances") {
+		o, n := d.GetChange("instances")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		removed := expandStringList(os.Difference(ns).List())
+		added := expandStringList(ns.Difference(os).List())
+
+		if err := deregisterElbBackends(elbconn, d.Id(), removed); err != nil {
+			return err
+		}
+		if err := registerElbBackends(elbconn, d.Id(), added); err != nil {
+			return err
+		}
+
+		if d.Get("wait_for_in_service").(bool) {
+			if err := waitForElbBackendsInService(elbconn, d.Id(), added, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsElbBackendsRead(d, meta)
+}
+
+func resourceAwsElbBackendsDelete(d *schema.ResourceData, meta interface{}) error {
+	elbconn := meta.(*AWSClient).elbconn
+
+	instances := expandStringList(d.Get("instances").(*schema.Set).List())
+	err := deregisterElbBackends(elbconn, d.Id(), instances)
+	if err != nil && !isLoadBalancerNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsElbBackendsImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("elb", d.Id())
+	d.Set("wait_for_in_service", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func registerElbBackends(conn *elb.ELB, elbName string, instances []*string) error {
+	for _, batch := range elbBackendsBatches(instances) {
+		log.Printf("[INFO] Registering %d instances with ELB %s", len(batch), elbName)
+		_, err := conn.RegisterInstancesWithLoadBalancer(&elb.RegisterInstancesWithLoadBalancerInput{
+			LoadBalancerName: aws.String(elbName),
+			Instances:        batch,
+		})
+		if err != nil {
+			return fmt.Errorf("Failure registering instances with ELB %s: %s", elbName, err)
+		}
+	}
+
+	return nil
+}
+
+func deregisterElbBackends(conn *elb.ELB, elbName string, instances []*string) error {
+	for _, batch := range elbBackendsBatches(instances) {
+		log.Printf("[INFO] Deregistering %d instances from ELB %s", len(batch), elbName)
+		_, err := conn.DeregisterInstancesFromLoadBalancer(&elb.DeregisterInstancesFromLoadBalancerInput{
+			LoadBalancerName: aws.String(elbName),
+			Instances:        batch,
+		})
+		if err != nil {
+			if isLoadBalancerNotFound(err) {
+				return err
+			}
+			return fmt.Errorf("Failure deregistering instances from ELB %s: %s", elbName, err)
+		}
+	}
+
+	return nil
+}
+
+// elbBackendsBatches splits the given instance IDs into batches of at most
+// elbBackendsBatchSize instances, in a stable order.
+func elbBackendsBatches(instances []*string) [][]*elb.Instance {
+	ids := make([]string, 0, len(instances))
+	for _, id := range instances {
+		ids = append(ids, aws.StringValue(id))
+	}
+	sort.Strings(ids)
+
+	var batches [][]*elb.Instance
+	for i := 0; i < len(ids); i += elbBackendsBatchSize {
+		j := i + elbBackendsBatchSize
+		if j > len(ids) {
+			j = len(ids)
+		}
+
+		batch := make([]*elb.Instance, 0, j-i)
+		for _, id := range ids[i:j] {
+			batch = append(batch, &elb.Instance{InstanceId: aws.String(id)})
+		}
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+func waitForElbBackendsInService(conn *elb.ELB, elbName string, instances []*string, timeout time.Duration) error {
+	if len(instances) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting for %d instances of ELB %s to be InService", len(instances), elbName)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"InService"},
+		Refresh:    elbBackendsInServiceRefreshFunc(conn, elbName, instances),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for instances of ELB %s to be InService: %s", elbName, err)
+	}
+
+	return nil
+}
+
+func elbBackendsInServiceRefreshFunc(conn *elb.ELB, elbName string, instances []*string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		req := &elb.DescribeInstanceHealthInput{
+			LoadBalancerName: aws.String(elbName),
+		}
+		for _, id := range instances {
+			req.Instances = append(req.Instances, &elb.Instance{InstanceId: id})
+		}
+
+		resp, err := conn.DescribeInstanceHealth(req)
+		if err != nil {
+			return nil, "", err
+		}
+
+		var pending []string
+		for _, s := range resp.InstanceStates {
+			if aws.StringValue(s.State) != "InService" {
+				pending = append(pending, aws.StringValue(s.InstanceId))
+			}
+		}
+		if len(pending) > 0 {
+			log.Printf("[DEBUG] ELB %s instances not yet InService: %s", elbName, strings.Join(pending, ", "))
+			return resp, "pending", nil
+		}
+
+		return resp, "InService", nil
+	}
+}
@@ -0,0 +1,178 @@
+package osc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestElbBackendsBatches(t *testing.T) {
+	var instances []*string
+	for i := 45; i > 0; i-- {
+		instances = append(instances, aws.String(fmt.Sprintf("i-%08d", i)))
+	}
+
+	batches := elbBackendsBatches(instances)
+	if len(batches) != 3 {
+		t.Fatalf("Expected 3 batches, got %d", len(batches))
+	}
+	for i, expected := range []int{20, 20, 5} {
+		if len(batches[i]) != expected {
+			t.Fatalf("Expected %d instances in batch %d, got %d", expected, i, len(batches[i]))
+		}
+	}
+	if id := *batches[0][0].InstanceId; id != "i-00000001" {
+		t.Fatalf("Expected batches to be sorted, first instance is %s", id)
+	}
+
+	if batches := elbBackendsBatches(nil); len(batches) != 0 {
+		t.Fatalf("Expected no batch for no instances, got %d", len(batches))
+	}
+}
+
+func TestAccAWSELBBackends_basic(t *testing.T) {
+	var conf elb.LoadBalancerDescription
+
+	testCheckInstanceAttached := func(count int) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if len(conf.Instances) != count {
+				return fmt.Errorf("expected %d instances, got %d", count, len(conf.Instances))
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSELBDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSELBBackendsConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSELBExists("aws_elb.bar", &conf),
+					testCheckInstanceAttached(2),
+					resource.TestCheckResourceAttr("aws_elb_backends.bar", "instances.#", "2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "aws_elb_backends.bar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_in_service"},
+			},
+			resource.TestStep{
+				Config: testAccAWSELBBackendsConfig(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSELBExists("aws_elb.bar", &conf),
+					testCheckInstanceAttached(1),
+					resource.TestCheckResourceAttr("aws_elb_backends.bar", "instances.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// Registers an instance with the ELB before the resource exists and checks
+// the first apply deregisters it.
+func TestAccAWSELBBackends_preRegistered(t *testing.T) {
+	var conf elb.LoadBalancerDescription
+	var extraInstanceId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSELBDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSELBBackendsConfigNoBackends,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSELBExists("aws_elb.bar", &conf),
+					testCheckResourceGetAttr("aws_instance.foo.1", "id", &extraInstanceId),
+					testAccAWSELBBackendsRegister("aws_elb.bar", &extraInstanceId),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSELBBackendsConfig(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSELBExists("aws_elb.bar", &conf),
+					func(*terraform.State) error {
+						if len(conf.Instances) != 1 {
+							return fmt.Errorf("expected 1 instance, got %d", len(conf.Instances))
+						}
+						if *conf.Instances[0].InstanceId == extraInstanceId {
+							return fmt.Errorf("instance %s registered outside of aws_elb_backends was kept", extraInstanceId)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("aws_elb_backends.bar", "instances.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSELBBackendsRegister(elbResource string, instanceId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[elbResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", elbResource)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		return registerElbBackends(conn, rs.Primary.ID, []*string{instanceId})
+	}
+}
+
+const testAccAWSELBBackendsConfigNoBackends = `
+resource "aws_elb" "bar" {
+  availability_zones = ["us-west-2a", "us-west-2b", "us-west-2c"]
+
+  listener {
+    instance_port     = 8000
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+}
+
+resource "aws_instance" "foo" {
+  count = 2
+
+  # us-west-2
+  ami           = "ami-043a5034"
+  instance_type = "t1.micro"
+}
+`
+
+func testAccAWSELBBackendsConfig(count int) string {
+	return fmt.Sprintf(`
+resource "aws_elb" "bar" {
+  availability_zones = ["us-west-2a", "us-west-2b", "us-west-2c"]
+
+  listener {
+    instance_port     = 8000
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+}
+
+resource "aws_instance" "foo" {
+  count = 2
+
+  # us-west-2
+  ami           = "ami-043a5034"
+  instance_type = "t1.micro"
+}
+
+resource "aws_elb_backends" "bar" {
+  elb       = "${aws_elb.bar.id}"
+  instances = ["${slice(aws_instance.foo.*.id, 0, %d)}"]
+}
+`, count)
+}
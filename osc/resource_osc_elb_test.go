@@ -172,6 +172,22 @@ func TestAccAWSELB_AccessLogs_disabled(t *testing.T) {
 	})
 }
 
+func TestAccAWSELB_AccessLogs_missingBucket(t *testing.T) {
+	bucketName := fmt.Sprintf("terraform-access-logs-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSELBDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccAWSELBAccessLogsMissingBucket(bucketName),
+				ExpectError: regexp.MustCompile("does not exist"),
+			},
+		},
+	})
+}
+
 func TestAccAWSELB_generatedName(t *testing.T) {
 	var conf elb.LoadBalancerDescription
 	generatedNameRegexp := regexp.MustCompile("^tf-lb-")
@@ -609,7 +625,173 @@ func TestAccAWSELB_SecurityGroups(t *testing.T) {
 	})
 }
 
+func TestAccAWSELBUpdate_CrossZoneLoadBalancing(t *testing.T) {
+	var conf elb.LoadBalancerDescription
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_elb.bar",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSELBDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSELBConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSELBExists("aws_elb.bar", &conf),
+					resource.TestCheckResourceAttr(
+						"aws_elb.bar", "cross_zone_load_balancing", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSELBConfigCrossZoneDisabled,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSELBExists("aws_elb.bar", &conf),
+					resource.TestCheckResourceAttr(
+						"aws_elb.bar", "cross_zone_load_balancing", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestElbListenerCertificateChanges(t *testing.T) {
+	listener := func(lbPort int64, protocol, cert string) *elb.Listener {
+		l := &elb.Listener{
+			InstancePort:     aws.Int64(8000),
+			InstanceProtocol: aws.String("http"),
+			LoadBalancerPort: aws.Int64(lbPort),
+			Protocol:         aws.String(protocol),
+		}
+		if cert != "" {
+			l.SSLCertificateId = aws.String(cert)
+		}
+		return l
+	}
+
+	cases := map[string]struct {
+		Remove, Add                    []*elb.Listener
+		CertUpdates, NewRemove, NewAdd int
+	}{
+		"certificate only": {
+			Remove:      []*elb.Listener{listener(443, "https", "arn:old")},
+			Add:         []*elb.Listener{listener(443, "HTTPS", "arn:new")},
+			CertUpdates: 1,
+		},
+		"port change": {
+			Remove:    []*elb.Listener{listener(443, "https", "arn:old")},
+			Add:       []*elb.Listener{listener(8443, "https", "arn:new")},
+			NewRemove: 1,
+			NewAdd:    1,
+		},
+		"protocol change": {
+			Remove:    []*elb.Listener{listener(443, "http", "")},
+			Add:       []*elb.Listener{listener(443, "https", "arn:new")},
+			NewRemove: 1,
+			NewAdd:    1,
+		},
+		"mixed": {
+			Remove: []*elb.Listener{
+				listener(443, "https", "arn:old"),
+				listener(80, "http", ""),
+			},
+			Add: []*elb.Listener{
+				listener(443, "https", "arn:new"),
+				listener(8080, "http", ""),
+			},
+			CertUpdates: 1,
+			NewRemove:   1,
+			NewAdd:      1,
+		},
+	}
+
+	for name, tc := range cases {
+		certUpdates, remove, add := elbListenerCertificateChanges(tc.Remove, tc.Add)
+		if len(certUpdates) != tc.CertUpdates || len(remove) != tc.NewRemove || len(add) != tc.NewAdd {
+			t.Fatalf("%s: expected %d/%d/%d certificate updates/removals/additions, got %d/%d/%d",
+				name, tc.CertUpdates, tc.NewRemove, tc.NewAdd, len(certUpdates), len(remove), len(add))
+		}
+		for _, l := range certUpdates {
+			if *l.SSLCertificateId != "arn:new" {
+				t.Fatalf("%s: expected the new certificate, got %s", name, *l.SSLCertificateId)
+			}
+		}
+	}
+}
+
 // Unit test for listeners hash
+func TestElbAccessLogsPolicyAllows(t *testing.T) {
+	statement := func(effect, principal, action, resource string) string {
+		return fmt.Sprintf(`{"Effect": %q, "Principal": %s, "Action": %s, "Resource": %s}`,
+			effect, principal, action, resource)
+	}
+
+	cases := []struct {
+		Name      string
+		Policy    string
+		AccountId string
+		Expected  bool
+	}{
+		{
+			Name: "allow under prefix",
+			Policy: `{"Statement": [` + statement("Allow", `{"AWS": "arn:aws:iam::111111111111:root"}`,
+				`"s3:PutObject"`, `"arn:aws:s3:::my-logs/lb/*"`) + `]}`,
+			Expected: true,
+		},
+		{
+			Name: "single statement and lists",
+			Policy: `{"Statement": ` + statement("Allow", `{"AWS": ["222222222222", "111111111111"]}`,
+				`["s3:GetObject", "s3:*"]`, `["arn:aws:s3:::my-logs/lb/AWSLogs/123456789012/*"]`) + `}`,
+			AccountId: "123456789012",
+			Expected:  true,
+		},
+		{
+			Name: "account restricted grant with unknown account",
+			Policy: `{"Statement": [` + statement("Allow", `{"AWS": "111111111111"}`,
+				`"s3:PutObject"`, `"arn:aws:s3:::my-logs/lb/AWSLogs/123456789012/*"`) + `]}`,
+			Expected: true,
+		},
+		{
+			Name: "grant for another account",
+			Policy: `{"Statement": [` + statement("Allow", `{"AWS": "arn:aws:iam::222222222222:root"}`,
+				`"*"`, `"arn:aws:s3:::my-logs/*"`) + `]}`,
+			Expected: false,
+		},
+		{
+			Name: "grant under another prefix",
+			Policy: `{"Statement": [` + statement("Allow", `{"AWS": "111111111111"}`,
+				`"s3:PutObject"`, `"arn:aws:s3:::my-logs/other/*"`) + `]}`,
+			Expected: false,
+		},
+		{
+			Name: "read only grant",
+			Policy: `{"Statement": [` + statement("Allow", `{"AWS": "111111111111"}`,
+				`"s3:GetObject"`, `"arn:aws:s3:::my-logs/*"`) + `]}`,
+			Expected: false,
+		},
+		{
+			Name: "denied",
+			Policy: `{"Statement": [` + statement("Allow", `"*"`,
+				`"s3:PutObject"`, `"arn:aws:s3:::my-logs/*"`) + `, ` + statement("Deny", `{"AWS": "111111111111"}`,
+				`"s3:Put*"`, `"arn:aws:s3:::my-logs/lb/*"`) + `]}`,
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		allowed, err := elbAccessLogsPolicyAllows(tc.Policy, "aws", "111111111111", "my-logs", "lb", tc.AccountId)
+		if err != nil {
+			t.Fatalf("%s: bad: %s", tc.Name, err)
+		}
+		if allowed != tc.Expected {
+			t.Fatalf("%s: expected %t, got %t", tc.Name, tc.Expected, allowed)
+		}
+	}
+
+	if _, err := elbAccessLogsPolicyAllows(`{"Statement": 1}`, "aws", "111111111111", "my-logs", "lb", ""); err == nil {
+		t.Fatalf("expected an error for a malformed policy")
+	}
+}
+
 func TestResourceAwsElbListenerHash(t *testing.T) {
 	cases := map[string]struct {
 		Left  map[string]interface{}
@@ -1018,6 +1200,25 @@ resource "aws_elb" "bar" {
 }
 `
 
+const testAccAWSELBConfigCrossZoneDisabled = `
+resource "aws_elb" "bar" {
+  availability_zones = ["us-west-2a", "us-west-2b", "us-west-2c"]
+
+  listener {
+    instance_port = 8000
+    instance_protocol = "http"
+    lb_port = 80
+    lb_protocol = "HttP"
+  }
+
+	tags {
+		bar = "baz"
+	}
+
+  cross_zone_load_balancing = false
+}
+`
+
 const testAccAWSELBFullRangeOfCharacters = `
 resource "aws_elb" "foo" {
   name = "%s"
@@ -1091,6 +1292,26 @@ resource "aws_elb" "foo" {
 `, r, r)
 }
 
+func testAccAWSELBAccessLogsMissingBucket(r string) string {
+	return fmt.Sprintf(`
+resource "aws_elb" "foo" {
+  availability_zones = ["us-west-2a", "us-west-2b", "us-west-2c"]
+
+  listener {
+    instance_port = 8000
+    instance_protocol = "http"
+    lb_port = 80
+    lb_protocol = "http"
+  }
+
+	access_logs {
+		interval = 5
+		bucket = "%s"
+	}
+}
+`, r)
+}
+
 func testAccAWSELBAccessLogsDisabled(r string) string {
 	return fmt.Sprintf(`
 # an S3 bucket configured for Access logs
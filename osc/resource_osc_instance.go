@@ -32,6 +32,8 @@ func resourceAwsInstance() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsInstanceMigrateState,
 
+		CustomizeDiff: resourceAwsInstanceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -196,6 +198,10 @@ func resourceAwsInstance() *schema.Resource {
 				Removed:  "Split out into three sub-types; see Changelog and Docs",
 			},
 
+			// Devices can be added and removed in place, see
+			// resourceAwsInstanceUpdateEbsBlockDevices. Changing an attached
+			// device still forces a new instance, see
+			// resourceAwsInstanceCustomizeDiff.
 			"ebs_block_device": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -206,48 +212,41 @@ func resourceAwsInstance() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  true,
-							ForceNew: true,
 						},
 
 						"device_name": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 
 						"encrypted": {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"iops": {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"snapshot_id": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"volume_size": {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"volume_type": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -629,6 +628,13 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("ebs_block_device") && !d.IsNewResource() {
+		if err := resourceAwsInstanceUpdateEbsBlockDevices(d, conn); err != nil {
+			return err
+		}
+		d.SetPartial("ebs_block_device")
+	}
+
 	// TODO(mitchellh): wait for the attributes we modified to
 	// persist the change...
 
@@ -637,6 +643,247 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceAwsInstanceRead(d, meta)
 }
 
+// resourceAwsInstanceCustomizeDiff forces a new instance when an attached
+// EBS block device is changed, as opposed to added or removed, since that
+// can't be done without replacing the volume and its data. Only
+// delete_on_termination can be changed in place.
+func resourceAwsInstanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("ebs_block_device") {
+		return nil
+	}
+
+	o, n := diff.GetChange("ebs_block_device")
+	oldDevices := ebsBlockDevicesByName(o.(*schema.Set))
+	newDevices := ebsBlockDevicesByName(n.(*schema.Set))
+
+	for name, nbd := range newDevices {
+		obd, ok := oldDevices[name]
+		if !ok {
+			continue
+		}
+		if ebsBlockDeviceVolumeChanged(obd, nbd) {
+			log.Printf("[DEBUG] EBS block device %s of instance %s changed, forcing a new instance", name, diff.Id())
+			return diff.ForceNew("ebs_block_device")
+		}
+	}
+
+	return nil
+}
+
+func ebsBlockDevicesByName(s *schema.Set) map[string]map[string]interface{} {
+	devices := make(map[string]map[string]interface{})
+	for _, v := range s.List() {
+		bd := v.(map[string]interface{})
+		devices[bd["device_name"].(string)] = bd
+	}
+	return devices
+}
+
+// ebsBlockDeviceVolumeChanged reports whether the volume behind a device would
+// have to be replaced to go from o to n. Computed attributes left unset in the
+// configuration don't count as changes.
+func ebsBlockDeviceVolumeChanged(o, n map[string]interface{}) bool {
+	if o["snapshot_id"].(string) != n["snapshot_id"].(string) {
+		return true
+	}
+	if v := n["volume_size"].(int); v != 0 && v != o["volume_size"].(int) {
+		return true
+	}
+	if v := n["volume_type"].(string); v != "" && v != o["volume_type"].(string) {
+		return true
+	}
+	if v := n["iops"].(int); v != 0 && v != o["iops"].(int) {
+		return true
+	}
+	if v := n["encrypted"].(bool); v && !o["encrypted"].(bool) {
+		return true
+	}
+	return false
+}
+
+// resourceAwsInstanceUpdateEbsBlockDevices applies the changes made to the
+// non-root ebs_block_device entries of a running instance: new devices get a
+// volume created and attached, removed ones are detached. Detached volumes
+// are kept whatever their delete_on_termination, as they may hold data.
+func resourceAwsInstanceUpdateEbsBlockDevices(d *schema.ResourceData, conn *ec2.EC2) error {
+	o, n := d.GetChange("ebs_block_device")
+	oldDevices := ebsBlockDevicesByName(o.(*schema.Set))
+	newDevices := ebsBlockDevicesByName(n.(*schema.Set))
+
+	volumeIds, err := instanceVolumeIdsByDeviceName(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	for name := range oldDevices {
+		if _, ok := newDevices[name]; ok {
+			continue
+		}
+		volumeId, ok := volumeIds[name]
+		if !ok {
+			log.Printf("[DEBUG] EBS block device %s is no longer attached to instance %s", name, d.Id())
+			continue
+		}
+		if err := detachInstanceEbsBlockDevice(conn, d, name, volumeId); err != nil {
+			return err
+		}
+	}
+
+	for name, nbd := range newDevices {
+		obd, ok := oldDevices[name]
+		if !ok {
+			if err := attachInstanceEbsBlockDevice(conn, d, nbd); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if nbd["delete_on_termination"].(bool) != obd["delete_on_termination"].(bool) {
+			if err := setInstanceEbsBlockDeviceDeleteOnTermination(conn, d.Id(), name, nbd["delete_on_termination"].(bool)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func instanceVolumeIdsByDeviceName(conn *ec2.EC2, instanceId string) (map[string]string, error) {
+	resp, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: []*string{aws.String(instanceId)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading block devices of instance %s: %s", instanceId, err)
+	}
+	if len(resp.Reservations) == 0 || len(resp.Reservations[0].Instances) == 0 {
+		return nil, fmt.Errorf("Error reading block devices of instance %s: instance not found", instanceId)
+	}
+
+	volumeIds := make(map[string]string)
+	for _, bd := range resp.Reservations[0].Instances[0].BlockDeviceMappings {
+		if bd.DeviceName != nil && bd.Ebs != nil && bd.Ebs.VolumeId != nil {
+			volumeIds[*bd.DeviceName] = *bd.Ebs.VolumeId
+		}
+	}
+	return volumeIds, nil
+}
+
+func attachInstanceEbsBlockDevice(conn *ec2.EC2, d *schema.ResourceData, bd map[string]interface{}) error {
+	name := bd["device_name"].(string)
+
+	req := &ec2.CreateVolumeInput{
+		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+	}
+	if v := bd["snapshot_id"].(string); v != "" {
+		req.SnapshotId = aws.String(v)
+	}
+	if v := bd["encrypted"].(bool); v {
+		req.Encrypted = aws.Bool(v)
+	}
+	if v := bd["volume_size"].(int); v != 0 {
+		req.Size = aws.Int64(int64(v))
+	}
+	if v := bd["volume_type"].(string); v != "" {
+		req.VolumeType = aws.String(v)
+	}
+	if v := bd["iops"].(int); v > 0 {
+		req.Iops = aws.Int64(int64(v))
+	}
+
+	log.Printf("[DEBUG] Creating EBS volume for device %s of instance %s: %s", name, d.Id(), req)
+	vol, err := conn.CreateVolume(req)
+	if err != nil {
+		return fmt.Errorf("Error creating EBS volume for device %s of instance %s: %s", name, d.Id(), err)
+	}
+	volumeId := *vol.VolumeId
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    volumeStateRefreshFunc(conn, volumeId),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EBS volume (%s) to become available: %s", volumeId, err)
+	}
+
+	log.Printf("[DEBUG] Attaching EBS volume %s to instance %s as %s", volumeId, d.Id(), name)
+	_, err = conn.AttachVolume(&ec2.AttachVolumeInput{
+		Device:     aws.String(name),
+		InstanceId: aws.String(d.Id()),
+		VolumeId:   aws.String(volumeId),
+	})
+	if err != nil {
+		return fmt.Errorf("Error attaching EBS volume %s to instance %s as %s: %s", volumeId, d.Id(), name, err)
+	}
+
+	stateConf = &resource.StateChangeConf{
+		Pending:    []string{"attaching"},
+		Target:     []string{"attached"},
+		Refresh:    volumeAttachmentStateRefreshFunc(conn, volumeId, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EBS volume (%s) to attach to instance %s: %s", volumeId, d.Id(), err)
+	}
+
+	// Volumes attached after launch are kept on termination unless told
+	// otherwise.
+	return setInstanceEbsBlockDeviceDeleteOnTermination(conn, d.Id(), name, bd["delete_on_termination"].(bool))
+}
+
+func detachInstanceEbsBlockDevice(conn *ec2.EC2, d *schema.ResourceData, name, volumeId string) error {
+	log.Printf("[DEBUG] Detaching EBS volume %s (%s) from instance %s", volumeId, name, d.Id())
+	_, err := conn.DetachVolume(&ec2.DetachVolumeInput{
+		Device:     aws.String(name),
+		InstanceId: aws.String(d.Id()),
+		VolumeId:   aws.String(volumeId),
+	})
+	if err != nil {
+		return fmt.Errorf("Error detaching EBS volume %s from instance %s: %s", volumeId, d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"detaching"},
+		Target:     []string{"detached"},
+		Refresh:    volumeAttachmentStateRefreshFunc(conn, volumeId, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EBS volume (%s) to detach from instance %s: %s", volumeId, d.Id(), err)
+	}
+
+	log.Printf("[INFO] EBS volume %s was detached from instance %s and is kept, it has to be deleted once no longer needed", volumeId, d.Id())
+
+	return nil
+}
+
+func setInstanceEbsBlockDeviceDeleteOnTermination(conn *ec2.EC2, instanceId, name string, deleteOnTermination bool) error {
+	log.Printf("[DEBUG] Setting delete_on_termination of device %s of instance %s to %t", name, instanceId, deleteOnTermination)
+	_, err := conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+		InstanceId: aws.String(instanceId),
+		BlockDeviceMappings: []*ec2.InstanceBlockDeviceMappingSpecification{
+			{
+				DeviceName: aws.String(name),
+				Ebs: &ec2.EbsInstanceBlockDeviceSpecification{
+					DeleteOnTermination: aws.Bool(deleteOnTermination),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting delete_on_termination of device %s of instance %s: %s", name, instanceId, err)
+	}
+
+	return nil
+}
+
 func resourceAwsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
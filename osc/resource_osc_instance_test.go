@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -722,6 +723,143 @@ func TestAccAWSInstance_rootBlockDeviceMismatch(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_ebsBlockDeviceUpdate(t *testing.T) {
+	var before, added, after ec2.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckInstanceDestroy,
+			// Detached volumes are kept, and so is /dev/sdc created with
+			// delete_on_termination false.
+			testAccDeleteInstanceEbsVolumes(&added),
+		),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInstanceConfigEbsBlockDevice,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &before),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccInstanceConfigEbsBlockDeviceAdded,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &added),
+					testAccCheckInstanceNotRecreated(&before, &added),
+					testAccCheckInstanceBlockDevice(&added, "/dev/sdc"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccInstanceConfigEbsBlockDeviceRemoved,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestEbsBlockDeviceVolumeChanged(t *testing.T) {
+	old := map[string]interface{}{
+		"device_name":           "/dev/sdb",
+		"delete_on_termination": true,
+		"encrypted":             false,
+		"iops":                  0,
+		"snapshot_id":           "",
+		"volume_size":           10,
+		"volume_type":           "standard",
+	}
+
+	cases := []struct {
+		Change   map[string]interface{}
+		Expected bool
+	}{
+		{map[string]interface{}{}, false},
+		{map[string]interface{}{"delete_on_termination": false}, false},
+		{map[string]interface{}{"volume_size": 0, "volume_type": ""}, false},
+		{map[string]interface{}{"volume_size": 20}, true},
+		{map[string]interface{}{"volume_type": "io1", "iops": 100}, true},
+		{map[string]interface{}{"snapshot_id": "snap-12345678"}, true},
+		{map[string]interface{}{"encrypted": true}, true},
+	}
+
+	for i, tc := range cases {
+		n := make(map[string]interface{})
+		for k, v := range old {
+			n[k] = v
+		}
+		for k, v := range tc.Change {
+			n[k] = v
+		}
+
+		if got := ebsBlockDeviceVolumeChanged(old, n); got != tc.Expected {
+			t.Fatalf("%d: expected %t, got %t for %#v", i, tc.Expected, got, tc.Change)
+		}
+	}
+}
+
+func testAccCheckInstanceNotRecreated(before, after *ec2.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *before.InstanceId != *after.InstanceId {
+			return fmt.Errorf("Instance was recreated: %s became %s", *before.InstanceId, *after.InstanceId)
+		}
+		return nil
+	}
+}
+
+func testAccCheckInstanceBlockDevice(instance *ec2.Instance, deviceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, bd := range instance.BlockDeviceMappings {
+			if *bd.DeviceName == deviceName {
+				return nil
+			}
+		}
+		return fmt.Errorf("block device doesn't exist: %s", deviceName)
+	}
+}
+
+// testAccDeleteInstanceEbsVolumes deletes the non-root EBS volumes that were
+// attached to an instance and outlived it.
+func testAccDeleteInstanceEbsVolumes(instance *ec2.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		for _, bd := range instance.BlockDeviceMappings {
+			if bd.Ebs == nil || bd.Ebs.VolumeId == nil ||
+				aws.StringValue(bd.DeviceName) == aws.StringValue(instance.RootDeviceName) {
+				continue
+			}
+
+			volumeId := *bd.Ebs.VolumeId
+			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+				_, err := conn.DeleteVolume(&ec2.DeleteVolumeInput{
+					VolumeId: aws.String(volumeId),
+				})
+				if err == nil || isAWSErr(err, "InvalidVolume.NotFound", "") {
+					return nil
+				}
+				if isAWSErr(err, "VolumeInUse", "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			})
+			if err != nil {
+				return fmt.Errorf("Error deleting EBS volume %s: %s", volumeId, err)
+			}
+		}
+
+		return nil
+	}
+}
+
 // This test reproduces the bug here:
 //   https://github.com/hashicorp/terraform/issues/1752
 //
@@ -978,6 +1116,48 @@ resource "aws_instance" "foo" {
 }
 `
 
+const testAccInstanceConfigEbsBlockDevice = `
+resource "aws_instance" "foo" {
+	ami = "ami-55a7ea65"
+	instance_type = "m3.medium"
+
+	ebs_block_device {
+		device_name = "/dev/sdb"
+		volume_size = 9
+	}
+}
+`
+
+const testAccInstanceConfigEbsBlockDeviceAdded = `
+resource "aws_instance" "foo" {
+	ami = "ami-55a7ea65"
+	instance_type = "m3.medium"
+
+	ebs_block_device {
+		device_name = "/dev/sdb"
+		volume_size = 9
+	}
+	ebs_block_device {
+		device_name = "/dev/sdc"
+		volume_size = 10
+		delete_on_termination = false
+	}
+}
+`
+
+const testAccInstanceConfigEbsBlockDeviceRemoved = `
+resource "aws_instance" "foo" {
+	ami = "ami-55a7ea65"
+	instance_type = "m3.medium"
+
+	ebs_block_device {
+		device_name = "/dev/sdc"
+		volume_size = 10
+		delete_on_termination = true
+	}
+}
+`
+
 const testAccInstanceConfigSourceDestEnable = `
 resource "aws_vpc" "foo" {
 	cidr_block = "10.1.0.0/16"
@@ -21,6 +21,8 @@ func resourceAwsLBSSLNegotiationPolicy() *schema.Resource {
 		Read:   resourceAwsLBSSLNegotiationPolicyRead,
 		Delete: resourceAwsLBSSLNegotiationPolicyDelete,
 
+		CustomizeDiff: resourceAwsLBSSLNegotiationPolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -183,3 +185,59 @@ func resourceAwsLBSSLNegotiationPolicyParseId(id string) (string, string, string
 	parts := strings.SplitN(id, ":", 3)
 	return parts[0], parts[1], parts[2]
 }
+
+// resourceAwsLBSSLNegotiationPolicyCustomizeDiff checks at plan time that
+// every attribute name is used by one of the predefined SSL policies, i.e.
+// the catalog exposed by the osc_lb_ssl_policies data source.
+func resourceAwsLBSSLNegotiationPolicyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("attribute") {
+		return nil
+	}
+	if !diff.NewValueKnown("attribute") {
+		return nil
+	}
+
+	var names []string
+	for _, v := range diff.Get("attribute").(*schema.Set).List() {
+		names = append(names, v.(map[string]interface{})["name"].(string))
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	policies, err := describeLBSSLPolicies(meta.(*AWSClient).elbconn, nil)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		log.Printf("[WARN] No predefined SSL policy found, skipping validation of attribute names")
+		return nil
+	}
+
+	if unknown := lbSSLPolicyUnknownAttributes(policies, names); len(unknown) > 0 {
+		return fmt.Errorf("attribute: unknown SSL negotiation policy attributes %q, "+
+			"see the osc_lb_ssl_policies data source for the supported protocols and ciphers",
+			unknown)
+	}
+
+	return nil
+}
+
+// lbSSLPolicyUnknownAttributes returns the given attribute names which are
+// not used by any of the given policies.
+func lbSSLPolicyUnknownAttributes(policies []*elb.PolicyDescription, names []string) []string {
+	known := make(map[string]bool)
+	for _, p := range policies {
+		for _, attr := range p.PolicyAttributeDescriptions {
+			known[aws.StringValue(attr.AttributeName)] = true
+		}
+	}
+
+	var unknown []string
+	for _, name := range names {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
@@ -2,6 +2,8 @@ package osc
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -35,6 +37,50 @@ func TestAccAWSLBSSLNegotiationPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSLBSSLNegotiationPolicy_unknownAttribute(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBSSLNegotiationPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccSslNegotiationPolicyConfigUnknownAttribute,
+				ExpectError: regexp.MustCompile("unknown SSL negotiation policy attributes"),
+			},
+		},
+	})
+}
+
+func TestLBSSLPolicyUnknownAttributes(t *testing.T) {
+	policies := []*elb.PolicyDescription{
+		{
+			PolicyName: aws.String("ELBSecurityPolicy-2016-08"),
+			PolicyAttributeDescriptions: []*elb.PolicyAttributeDescription{
+				{AttributeName: aws.String("Protocol-TLSv1.2"), AttributeValue: aws.String("true")},
+				{AttributeName: aws.String("ECDHE-RSA-AES128-GCM-SHA256"), AttributeValue: aws.String("true")},
+			},
+		},
+		{
+			PolicyName: aws.String("ELBSecurityPolicy-2015-05"),
+			PolicyAttributeDescriptions: []*elb.PolicyAttributeDescription{
+				{AttributeName: aws.String("Server-Defined-Cipher-Order"), AttributeValue: aws.String("true")},
+			},
+		},
+	}
+
+	names := []string{
+		"Protocol-TLSv1.2",
+		"Server-Defined-Cipher-Order",
+		"ECDHE-RSA-AES128-GCM-SHA265",
+		"protocol-tlsv1.2",
+	}
+	expected := []string{"ECDHE-RSA-AES128-GCM-SHA265", "protocol-tlsv1.2"}
+
+	if unknown := lbSSLPolicyUnknownAttributes(policies, names); !reflect.DeepEqual(unknown, expected) {
+		t.Fatalf("expected %v, got %v", expected, unknown)
+	}
+}
+
 func testAccCheckLBSSLNegotiationPolicyDestroy(s *terraform.State) error {
 	elbconn := testAccProvider.Meta().(*AWSClient).elbconn
 
@@ -261,3 +307,27 @@ resource "aws_lb_ssl_negotiation_policy" "foo" {
 }
 `, certName)
 }
+
+const testAccSslNegotiationPolicyConfigUnknownAttribute = `
+resource "aws_elb" "lb" {
+  availability_zones = ["us-west-2a"]
+
+  listener {
+    instance_port = 8000
+    instance_protocol = "http"
+    lb_port = 80
+    lb_protocol = "http"
+  }
+}
+
+resource "aws_lb_ssl_negotiation_policy" "foo" {
+  name = "foo-policy"
+  load_balancer = "${aws_elb.lb.id}"
+  lb_port = 80
+
+  attribute {
+    name = "ECDHE-RSA-AES128-GCM-SHA265"
+    value = "true"
+  }
+}
+`
@@ -25,6 +25,8 @@ func resourceAwsNetworkInterface() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsNetworkInterfaceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 
 			"subnet_id": &schema.Schema{
@@ -48,6 +50,23 @@ func resourceAwsNetworkInterface() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			// Number of secondary private IPs picked by the subnet, as an
+			// alternative to listing them in private_ips.
+			"private_ips_count": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"private_ips"},
+			},
+
+			// Lets private IPs assigned to another interface of the subnet be
+			// moved to this one, e.g. for floating IPs.
+			"allow_reassignment": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"security_groups": &schema.Schema{
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -95,6 +114,15 @@ func resourceAwsNetworkInterface() *schema.Resource {
 	}
 }
 
+// The private IPs picked by the subnet are only known once the count has been
+// applied.
+func resourceAwsNetworkInterfaceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("private_ips_count") && !diff.HasChange("private_ips") {
+		return diff.SetNewComputed("private_ips")
+	}
+	return nil
+}
+
 func resourceAwsNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	conn := meta.(*AWSClient).ec2conn
@@ -115,6 +143,8 @@ func resourceAwsNetworkInterfaceCreate(d *schema.ResourceData, meta interface{})
 	private_ips := d.Get("private_ips").(*schema.Set).List()
 	if len(private_ips) != 0 {
 		request.PrivateIpAddresses = expandPrivateIPAddresses(private_ips)
+	} else if v, ok := d.GetOk("private_ips_count"); ok {
+		request.SecondaryPrivateIpAddressCount = aws.Int64(int64(v.(int)))
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -157,6 +187,7 @@ func resourceAwsNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("subnet_id", eni.SubnetId)
 	d.Set("private_ip", eni.PrivateIpAddress)
 	d.Set("private_ips", flattenNetworkInterfacesPrivateIPAddresses(eni.PrivateIpAddresses))
+	d.Set("private_ips_count", len(networkInterfaceSecondaryPrivateIPs(eni)))
 	d.Set("security_groups", flattenGroupIdentifiers(eni.Groups))
 	d.Set("source_dest_check", eni.SourceDestCheck)
 
@@ -259,7 +290,8 @@ func resourceAwsNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("attachment")
 	}
 
-	if d.HasChange("private_ips") {
+	// The private IPs were given to the interface when it was created.
+	if d.HasChange("private_ips") && !d.IsNewResource() {
 		o, n := d.GetChange("private_ips")
 		if o == nil {
 			o = new(schema.Set)
@@ -271,8 +303,9 @@ func resourceAwsNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{})
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 
-		// Unassign old IP addresses
+		// Unassign old IP addresses, except the primary one which can't be unassigned
 		unassignIps := os.Difference(ns)
+		unassignIps.Remove(d.Get("private_ip").(string))
 		if unassignIps.Len() != 0 {
 			input := &ec2.UnassignPrivateIpAddressesInput{
 				NetworkInterfaceId: aws.String(d.Id()),
@@ -290,6 +323,7 @@ func resourceAwsNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{})
 			input := &ec2.AssignPrivateIpAddressesInput{
 				NetworkInterfaceId: aws.String(d.Id()),
 				PrivateIpAddresses: expandStringList(assignIps.List()),
+				AllowReassignment:  aws.Bool(d.Get("allow_reassignment").(bool)),
 			}
 			_, err := conn.AssignPrivateIpAddresses(input)
 			if err != nil {
@@ -300,6 +334,15 @@ func resourceAwsNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("private_ips")
 	}
 
+	if d.HasChange("private_ips_count") && !d.HasChange("private_ips") && !d.IsNewResource() {
+		o, n := d.GetChange("private_ips_count")
+		if err := resourceAwsNetworkInterfaceUpdatePrivateIPsCount(d, conn, o.(int), n.(int)); err != nil {
+			return err
+		}
+
+		d.SetPartial("private_ips_count")
+	}
+
 	if d.Get("source_dest_check").(bool) {
 		request := &ec2.ModifyNetworkInterfaceAttributeInput{
 			NetworkInterfaceId: aws.String(d.Id()),
@@ -354,6 +397,60 @@ func resourceAwsNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{})
 	return resourceAwsNetworkInterfaceRead(d, meta)
 }
 
+// resourceAwsNetworkInterfaceUpdatePrivateIPsCount lets the subnet pick new
+// secondary private IPs, or unassigns the last ones of the interface.
+func resourceAwsNetworkInterfaceUpdatePrivateIPsCount(d *schema.ResourceData, conn *ec2.EC2, o, n int) error {
+	if n > o {
+		log.Printf("[DEBUG] Assigning %d secondary private IPs to ENI %s", n-o, d.Id())
+		_, err := conn.AssignPrivateIpAddresses(&ec2.AssignPrivateIpAddressesInput{
+			NetworkInterfaceId:             aws.String(d.Id()),
+			SecondaryPrivateIpAddressCount: aws.Int64(int64(n - o)),
+			AllowReassignment:              aws.Bool(d.Get("allow_reassignment").(bool)),
+		})
+		if err != nil {
+			return fmt.Errorf("Failure to assign Private IPs: %s", err)
+		}
+		return nil
+	}
+
+	eni, err := findNetworkInterface(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if eni == nil {
+		return fmt.Errorf("Unable to find ENI %s", d.Id())
+	}
+
+	secondary := networkInterfaceSecondaryPrivateIPs(eni)
+	if len(secondary) <= n {
+		return nil
+	}
+
+	unassignIps := secondary[n:]
+	log.Printf("[DEBUG] Unassigning private IPs %v from ENI %s", unassignIps, d.Id())
+	_, err = conn.UnassignPrivateIpAddresses(&ec2.UnassignPrivateIpAddressesInput{
+		NetworkInterfaceId: aws.String(d.Id()),
+		PrivateIpAddresses: aws.StringSlice(unassignIps),
+	})
+	if err != nil {
+		return fmt.Errorf("Failure to unassign Private IPs: %s", err)
+	}
+	return nil
+}
+
+// networkInterfaceSecondaryPrivateIPs returns the private IPs of an
+// interface other than its primary one, in the order they are listed.
+func networkInterfaceSecondaryPrivateIPs(eni *ec2.NetworkInterface) []string {
+	var ips []string
+	for _, ip := range eni.PrivateIpAddresses {
+		if aws.BoolValue(ip.Primary) {
+			continue
+		}
+		ips = append(ips, aws.StringValue(ip.PrivateIpAddress))
+	}
+	return ips
+}
+
 func resourceAwsNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
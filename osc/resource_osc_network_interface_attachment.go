@@ -0,0 +1,191 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAwsNetworkInterfaceAttachment attaches a network interface managed
+// elsewhere to an instance. The attachment block of aws_network_interface is
+// computed, so it picks up attachments made by this resource without a diff.
+func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkInterfaceAttachmentCreate,
+		Read:   resourceAwsNetworkInterfaceAttachmentRead,
+		Delete: resourceAwsNetworkInterfaceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_index": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"attachment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	iID := d.Get("instance_id").(string)
+	eniID := d.Get("network_interface_id").(string)
+
+	opts := &ec2.AttachNetworkInterfaceInput{
+		DeviceIndex:        aws.Int64(int64(d.Get("device_index").(int))),
+		InstanceId:         aws.String(iID),
+		NetworkInterfaceId: aws.String(eniID),
+	}
+
+	log.Printf("[DEBUG] Attaching Network Interface (%s) to Instance (%s)", eniID, iID)
+	resp, err := conn.AttachNetworkInterface(opts)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return fmt.Errorf("Error attaching network interface (%s) to instance (%s), message: \"%s\", code: \"%s\"",
+				eniID, iID, awsErr.Message(), awsErr.Code())
+		}
+		return err
+	}
+
+	attachmentID := *resp.AttachmentId
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"attaching"},
+		Target:     []string{"attached"},
+		Refresh:    networkInterfaceAttachmentStatusRefreshFunc(conn, eniID, attachmentID),
+		Timeout:    5 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for Network Interface (%s) to attach to Instance: %s, error: %s",
+			eniID, iID, err)
+	}
+
+	d.SetId(attachmentID)
+	return resourceAwsNetworkInterfaceAttachmentRead(d, meta)
+}
+
+// networkInterfaceAttachmentStatusRefreshFunc reports the status of an
+// attachment of a network interface, or "detached" once the interface no
+// longer has this attachment.
+func networkInterfaceAttachmentStatusRefreshFunc(conn *ec2.EC2, eniID, attachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+			NetworkInterfaceIds: []*string{aws.String(eniID)},
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				return nil, "failed", fmt.Errorf("code: %s, message: %s", awsErr.Code(), awsErr.Message())
+			}
+			return nil, "failed", err
+		}
+
+		if len(resp.NetworkInterfaces) > 0 {
+			a := resp.NetworkInterfaces[0].Attachment
+			if a != nil && aws.StringValue(a.AttachmentId) == attachmentID {
+				return a, *a.Status, nil
+			}
+		}
+		return 42, "detached", nil
+	}
+}
+
+func resourceAwsNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("attachment.attachment-id"),
+				Values: []*string{aws.String(d.Id())},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Network Interface Attachment (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.NetworkInterfaces) == 0 || resp.NetworkInterfaces[0].Attachment == nil {
+		log.Printf("[DEBUG] Network Interface Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	eni := resp.NetworkInterfaces[0]
+	d.Set("network_interface_id", eni.NetworkInterfaceId)
+	d.Set("instance_id", eni.Attachment.InstanceId)
+	d.Set("device_index", eni.Attachment.DeviceIndex)
+	d.Set("attachment_id", eni.Attachment.AttachmentId)
+	d.Set("status", eni.Attachment.Status)
+
+	return nil
+}
+
+func resourceAwsNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	eniID := d.Get("network_interface_id").(string)
+	iID := d.Get("instance_id").(string)
+
+	_, err := conn.DetachNetworkInterface(&ec2.DetachNetworkInterfaceInput{
+		AttachmentId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidAttachmentID.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Failed to detach Network Interface (%s) from Instance (%s): %s",
+			eniID, iID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"attached", "detaching"},
+		Target:     []string{"detached"},
+		Refresh:    networkInterfaceAttachmentStatusRefreshFunc(conn, eniID, d.Id()),
+		Timeout:    5 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Detaching Network Interface (%s) from Instance (%s)", eniID, iID)
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for Network Interface (%s) to detach from Instance: %s, error: %s",
+			eniID, iID, err)
+	}
+
+	return nil
+}
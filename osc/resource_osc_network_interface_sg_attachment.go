@@ -0,0 +1,203 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAwsNetworkInterfaceSGAttachment adds a security group to a network
+// interface managed elsewhere, keeping the other groups of the interface.
+// The interface must not list its security groups itself, or both resources
+// will fight over them.
+func resourceAwsNetworkInterfaceSGAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkInterfaceSGAttachmentCreate,
+		Read:   resourceAwsNetworkInterfaceSGAttachmentRead,
+		Delete: resourceAwsNetworkInterfaceSGAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkInterfaceSGAttachmentImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	sgID := d.Get("security_group_id").(string)
+	eniID := d.Get("network_interface_id").(string)
+
+	awsMutexKV.Lock(eniID)
+	defer awsMutexKV.Unlock(eniID)
+
+	eni, err := findNetworkInterface(conn, eniID)
+	if err != nil {
+		return err
+	}
+	if eni == nil {
+		return fmt.Errorf("Network Interface (%s) not found", eniID)
+	}
+
+	groups := networkInterfaceGroupIds(eni)
+	if groups[sgID] {
+		log.Printf("[DEBUG] Security Group (%s) is already attached to Network Interface (%s)", sgID, eniID)
+	} else {
+		groups[sgID] = true
+		log.Printf("[DEBUG] Attaching Security Group (%s) to Network Interface (%s)", sgID, eniID)
+		if err := setNetworkInterfaceGroups(conn, eniID, groups); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(networkInterfaceSGAttachmentID(eniID, sgID))
+	return resourceAwsNetworkInterfaceSGAttachmentRead(d, meta)
+}
+
+func resourceAwsNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	sgID := d.Get("security_group_id").(string)
+	eniID := d.Get("network_interface_id").(string)
+
+	eni, err := findNetworkInterface(conn, eniID)
+	if err != nil {
+		return err
+	}
+	if eni == nil || !networkInterfaceGroupIds(eni)[sgID] {
+		log.Printf("[WARN] Security Group (%s) not attached to Network Interface (%s), removing from state", sgID, eniID)
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	sgID := d.Get("security_group_id").(string)
+	eniID := d.Get("network_interface_id").(string)
+
+	awsMutexKV.Lock(eniID)
+	defer awsMutexKV.Unlock(eniID)
+
+	eni, err := findNetworkInterface(conn, eniID)
+	if err != nil {
+		return err
+	}
+	if eni == nil {
+		return nil
+	}
+
+	groups := networkInterfaceGroupIds(eni)
+	if !groups[sgID] {
+		return nil
+	}
+	delete(groups, sgID)
+
+	// A network interface always has at least one security group, the
+	// default group of the VPC takes over when the last one is detached.
+	if len(groups) == 0 {
+		defaultGroup, err := findVpcDefaultSecurityGroup(conn, aws.StringValue(eni.VpcId))
+		if err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Replacing the last Security Group (%s) of Network Interface (%s) by the default group %s",
+			sgID, eniID, defaultGroup)
+		groups[defaultGroup] = true
+	}
+
+	log.Printf("[DEBUG] Detaching Security Group (%s) from Network Interface (%s)", sgID, eniID)
+	return setNetworkInterfaceGroups(conn, eniID, groups)
+}
+
+func resourceAwsNetworkInterfaceSGAttachmentImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "_")
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "eni-") || !strings.HasPrefix(parts[1], "sg-") {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NETWORKINTERFACEID_SECURITYGROUPID", d.Id())
+	}
+
+	d.Set("network_interface_id", parts[0])
+	d.Set("security_group_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func networkInterfaceSGAttachmentID(eniID, sgID string) string {
+	return fmt.Sprintf("%s_%s", eniID, sgID)
+}
+
+// findNetworkInterface returns the network interface with the given ID, or
+// nil if it doesn't exist.
+func findNetworkInterface(conn *ec2.EC2, id string) (*ec2.NetworkInterface, error) {
+	resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidNetworkInterfaceID.NotFound", "") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error retrieving Network Interface (%s): %s", id, err)
+	}
+	if len(resp.NetworkInterfaces) == 0 {
+		return nil, nil
+	}
+
+	return resp.NetworkInterfaces[0], nil
+}
+
+func networkInterfaceGroupIds(eni *ec2.NetworkInterface) map[string]bool {
+	groups := make(map[string]bool, len(eni.Groups))
+	for _, g := range eni.Groups {
+		groups[aws.StringValue(g.GroupId)] = true
+	}
+	return groups
+}
+
+func setNetworkInterfaceGroups(conn *ec2.EC2, eniID string, groups map[string]bool) error {
+	ids := make([]*string, 0, len(groups))
+	for id := range groups {
+		ids = append(ids, aws.String(id))
+	}
+
+	_, err := conn.ModifyNetworkInterfaceAttribute(&ec2.ModifyNetworkInterfaceAttributeInput{
+		NetworkInterfaceId: aws.String(eniID),
+		Groups:             ids,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Security Groups of Network Interface (%s): %s", eniID, err)
+	}
+	return nil
+}
+
+func findVpcDefaultSecurityGroup(conn *ec2.EC2, vpcID string) (string, error) {
+	resp, err := conn.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"vpc-id":     vpcID,
+			"group-name": "default",
+		}),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error reading default Security Group of VPC (%s): %s", vpcID, err)
+	}
+	if len(resp.SecurityGroups) != 1 {
+		return "", fmt.Errorf("Default Security Group of VPC (%s) not found", vpcID)
+	}
+
+	return *resp.SecurityGroups[0].GroupId, nil
+}
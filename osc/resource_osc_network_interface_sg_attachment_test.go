@@ -0,0 +1,116 @@
+package osc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsNetworkInterfaceSGAttachmentImportState(t *testing.T) {
+	r := resourceAwsNetworkInterfaceSGAttachment()
+
+	d := r.Data(nil)
+	d.SetId("eni-12345678_sg-12345678")
+	results, err := resourceAwsNetworkInterfaceSGAttachmentImportState(d, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 result, got %d", len(results))
+	}
+	if v := d.Get("network_interface_id").(string); v != "eni-12345678" {
+		t.Fatalf("Bad network_interface_id: %s", v)
+	}
+	if v := d.Get("security_group_id").(string); v != "sg-12345678" {
+		t.Fatalf("Bad security_group_id: %s", v)
+	}
+
+	for _, id := range []string{"eni-12345678", "sg-12345678_eni-12345678", "eni-1_sg-1_sg-2"} {
+		d := r.Data(nil)
+		d.SetId(id)
+		if _, err := resourceAwsNetworkInterfaceSGAttachmentImportState(d, nil); err == nil {
+			t.Fatalf("%s: expected an error", id)
+		}
+	}
+}
+
+func TestAccAWSNetworkInterfaceSGAttachment_basic(t *testing.T) {
+	var conf ec2.NetworkInterface
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSENIDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSNetworkInterfaceSGAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSENIExists("aws_network_interface.bar", &conf),
+					testAccCheckAWSNetworkInterfaceHasGroup(&conf, "aws_security_group.foo"),
+					testAccCheckAWSNetworkInterfaceHasGroup(&conf, "aws_security_group.bar"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_network_interface_sg_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkInterfaceHasGroup(conf *ec2.NetworkInterface, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if !networkInterfaceGroupIds(conf)[rs.Primary.ID] {
+			return fmt.Errorf("Security Group %s not attached to Network Interface: %#v", rs.Primary.ID, conf.Groups)
+		}
+		return nil
+	}
+}
+
+const testAccAWSNetworkInterfaceSGAttachmentConfig = `
+resource "aws_vpc" "foo" {
+    cidr_block = "172.16.0.0/16"
+}
+
+resource "aws_subnet" "foo" {
+    vpc_id = "${aws_vpc.foo.id}"
+    cidr_block = "172.16.10.0/24"
+    availability_zone = "us-west-2a"
+}
+
+resource "aws_security_group" "foo" {
+  vpc_id = "${aws_vpc.foo.id}"
+  description = "foo"
+  name = "foo"
+}
+
+resource "aws_security_group" "bar" {
+  vpc_id = "${aws_vpc.foo.id}"
+  description = "bar"
+  name = "bar"
+}
+
+resource "aws_network_interface" "bar" {
+    subnet_id = "${aws_subnet.foo.id}"
+    private_ips = ["172.16.10.100"]
+}
+
+resource "aws_network_interface_sg_attachment" "base" {
+    security_group_id = "${aws_security_group.foo.id}"
+    network_interface_id = "${aws_network_interface.bar.id}"
+}
+
+resource "aws_network_interface_sg_attachment" "test" {
+    security_group_id = "${aws_security_group.bar.id}"
+    network_interface_id = "${aws_network_interface_sg_attachment.base.network_interface_id}"
+}
+`
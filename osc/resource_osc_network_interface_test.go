@@ -154,6 +154,64 @@ func TestAccAWSENI_computedIPs(t *testing.T) {
 	})
 }
 
+func TestAccAWSENI_privateIPsCount(t *testing.T) {
+	var conf ec2.NetworkInterface
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_network_interface.bar",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSENIDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSENIConfigWithPrivateIPsCount(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSENIExists("aws_network_interface.bar", &conf),
+					resource.TestCheckResourceAttr(
+						"aws_network_interface.bar", "private_ips_count", "2"),
+					resource.TestCheckResourceAttr(
+						"aws_network_interface.bar", "private_ips.#", "3"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSENIConfigWithPrivateIPsCount(3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSENIExists("aws_network_interface.bar", &conf),
+					resource.TestCheckResourceAttr(
+						"aws_network_interface.bar", "private_ips_count", "3"),
+					resource.TestCheckResourceAttr(
+						"aws_network_interface.bar", "private_ips.#", "4"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSENIConfigWithPrivateIPsCount(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSENIExists("aws_network_interface.bar", &conf),
+					resource.TestCheckResourceAttr(
+						"aws_network_interface.bar", "private_ips_count", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_network_interface.bar", "private_ips.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestNetworkInterfaceSecondaryPrivateIPs(t *testing.T) {
+	eni := &ec2.NetworkInterface{
+		PrivateIpAddresses: []*ec2.NetworkInterfacePrivateIpAddress{
+			{PrivateIpAddress: aws.String("172.16.10.11"), Primary: aws.Bool(false)},
+			{PrivateIpAddress: aws.String("172.16.10.10"), Primary: aws.Bool(true)},
+			{PrivateIpAddress: aws.String("172.16.10.12"), Primary: aws.Bool(false)},
+		},
+	}
+
+	ips := networkInterfaceSecondaryPrivateIPs(eni)
+	if len(ips) != 2 || ips[0] != "172.16.10.11" || ips[1] != "172.16.10.12" {
+		t.Fatalf("Bad secondary private IPs: %v", ips)
+	}
+}
+
 func testAccCheckAWSENIExists(n string, res *ec2.NetworkInterface) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -393,6 +451,25 @@ resource "aws_network_interface" "bar" {
 }
 `
 
+func testAccAWSENIConfigWithPrivateIPsCount(count int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+    cidr_block = "172.16.0.0/16"
+}
+
+resource "aws_subnet" "foo" {
+    vpc_id = "${aws_vpc.foo.id}"
+    cidr_block = "172.16.10.0/24"
+    availability_zone = "us-west-2a"
+}
+
+resource "aws_network_interface" "bar" {
+    subnet_id = "${aws_subnet.foo.id}"
+    private_ips_count = %d
+}
+`, count)
+}
+
 const testAccAWSENIConfigWithAttachment = `
 resource "aws_vpc" "foo" {
     cidr_block = "172.16.0.0/16"
@@ -27,6 +27,9 @@ func resourceAwsRoute() *schema.Resource {
 		Update: resourceAwsRouteUpdate,
 		Delete: resourceAwsRouteDelete,
 		Exists: resourceAwsRouteExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"destination_cidr_block": &schema.Schema{
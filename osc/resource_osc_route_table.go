@@ -136,9 +136,14 @@ func resourceAwsRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 	rt := rtRaw.(*ec2.RouteTable)
 	d.Set("vpc_id", rt.VpcId)
 
+	// Only the propagations this route table owns are read back, the others
+	// are managed by aws_vpn_gateway_route_propagation.
+	owned := d.Get("propagating_vgws").(*schema.Set)
 	propagatingVGWs := make([]string, 0, len(rt.PropagatingVgws))
 	for _, vgw := range rt.PropagatingVgws {
-		propagatingVGWs = append(propagatingVGWs, *vgw.GatewayId)
+		if owned.Contains(*vgw.GatewayId) {
+			propagatingVGWs = append(propagatingVGWs, *vgw.GatewayId)
+		}
 	}
 	d.Set("propagating_vgws", propagatingVGWs)
 
@@ -227,27 +232,7 @@ func resourceAwsRouteTableUpdate(d *schema.ResourceData, meta interface{}) error
 		for _, vgw := range add {
 			id := vgw.(string)
 
-			var err error
-			for i := 0; i < 5; i++ {
-				log.Printf("[INFO] Enabling VGW propagation for %s: %s", d.Id(), id)
-				_, err = conn.EnableVgwRoutePropagation(&ec2.EnableVgwRoutePropagationInput{
-					RouteTableId: aws.String(d.Id()),
-					GatewayId:    aws.String(id),
-				})
-				if err == nil {
-					break
-				}
-
-				// If we get a Gateway.NotAttached, it is usually some
-				// eventually consistency stuff. So we have to just wait a
-				// bit...
-				ec2err, ok := err.(awserr.Error)
-				if ok && ec2err.Code() == "Gateway.NotAttached" {
-					time.Sleep(20 * time.Second)
-					continue
-				}
-			}
-			if err != nil {
+			if err := enableVgwRoutePropagation(conn, d.Id(), id); err != nil {
 				return err
 			}
 
@@ -410,6 +395,33 @@ func resourceAwsRouteTableDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// enableVgwRoutePropagation enables the propagation of the routes of a VPN
+// gateway to a route table, retrying while the gateway is being attached.
+func enableVgwRoutePropagation(conn *ec2.EC2, routeTableId, gatewayId string) error {
+	var err error
+	for i := 0; i < 5; i++ {
+		log.Printf("[INFO] Enabling VGW propagation for %s: %s", routeTableId, gatewayId)
+		_, err = conn.EnableVgwRoutePropagation(&ec2.EnableVgwRoutePropagationInput{
+			RouteTableId: aws.String(routeTableId),
+			GatewayId:    aws.String(gatewayId),
+		})
+		if err == nil {
+			break
+		}
+
+		// If we get a Gateway.NotAttached, it is usually some
+		// eventually consistency stuff. So we have to just wait a
+		// bit...
+		ec2err, ok := err.(awserr.Error)
+		if ok && ec2err.Code() == "Gateway.NotAttached" {
+			time.Sleep(20 * time.Second)
+			continue
+		}
+	}
+
+	return err
+}
+
 func resourceAwsRouteTableHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
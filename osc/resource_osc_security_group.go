@@ -120,6 +120,12 @@ func resourceAwsSecurityGroup() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"description": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSecurityGroupRuleDescription,
+						},
 					},
 				},
 				Set: resourceAwsSecurityGroupRuleHash,
@@ -171,6 +177,12 @@ func resourceAwsSecurityGroup() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"description": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSecurityGroupRuleDescription,
+						},
 					},
 				},
 				Set: resourceAwsSecurityGroupRuleHash,
@@ -182,6 +194,14 @@ func resourceAwsSecurityGroup() *schema.Resource {
 			},
 
 			"tags": tagsSchema(),
+
+			// Groups referencing each other can't be deleted until the rules
+			// holding the references are gone.
+			"revoke_rules_on_delete": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -359,11 +379,13 @@ func resourceAwsSecurityGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] Security Group destroy: %v", d.Id())
 
-	if err := deleteLingeringLambdaENIs(conn, d); err != nil {
-		return fmt.Errorf("Failed to delete Lambda ENIs: %s", err)
+	if d.Get("revoke_rules_on_delete").(bool) {
+		if err := revokeSecurityGroupGroupRules(conn, d.Id()); err != nil {
+			return err
+		}
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		_, err := conn.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
 			GroupId: aws.String(d.Id()),
 		})
@@ -387,6 +409,113 @@ func resourceAwsSecurityGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 		return nil
 	})
+	if isAWSErr(err, "DependencyViolation", "") {
+		enis, eniErr := securityGroupNetworkInterfaces(conn, d.Id())
+		if eniErr != nil {
+			log.Printf("[WARN] Error listing network interfaces of Security Group (%s): %s", d.Id(), eniErr)
+		}
+		return securityGroupInUseError(d.Id(), enis, err)
+	}
+
+	return err
+}
+
+// revokeSecurityGroupGroupRules revokes the ingress and egress rules of a
+// group granting access to other security groups, so that groups referencing
+// each other can be deleted.
+func revokeSecurityGroupGroupRules(conn *ec2.EC2, id string) error {
+	sgRaw, _, err := SGStateRefreshFunc(conn, id)()
+	if err != nil {
+		return err
+	}
+	if sgRaw == nil {
+		return nil
+	}
+	group := sgRaw.(*ec2.SecurityGroup)
+
+	ingress := securityGroupGroupPermissions(group.IpPermissions)
+	if len(ingress) > 0 {
+		log.Printf("[DEBUG] Revoking %d ingress rules referencing security groups from %s", len(ingress), id)
+		req := &ec2.RevokeSecurityGroupIngressInput{
+			IpPermissions: ingress,
+		}
+		if group.VpcId == nil || *group.VpcId == "" {
+			req.GroupName = group.GroupName
+		} else {
+			req.GroupId = group.GroupId
+		}
+		if _, err := conn.RevokeSecurityGroupIngress(req); err != nil && !isAWSErr(err, "InvalidPermission.NotFound", "") {
+			return fmt.Errorf("Error revoking ingress rules of Security Group (%s): %s", id, err)
+		}
+	}
+
+	egress := securityGroupGroupPermissions(group.IpPermissionsEgress)
+	if len(egress) > 0 {
+		log.Printf("[DEBUG] Revoking %d egress rules referencing security groups from %s", len(egress), id)
+		_, err := conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: egress,
+		})
+		if err != nil && !isAWSErr(err, "InvalidPermission.NotFound", "") {
+			return fmt.Errorf("Error revoking egress rules of Security Group (%s): %s", id, err)
+		}
+	}
+
+	return nil
+}
+
+// securityGroupGroupPermissions keeps only the security group sources of the
+// given permissions, dropping those without any.
+func securityGroupGroupPermissions(perms []*ec2.IpPermission) []*ec2.IpPermission {
+	var result []*ec2.IpPermission
+	for _, perm := range perms {
+		if len(perm.UserIdGroupPairs) == 0 {
+			continue
+		}
+		result = append(result, &ec2.IpPermission{
+			IpProtocol:       perm.IpProtocol,
+			FromPort:         perm.FromPort,
+			ToPort:           perm.ToPort,
+			UserIdGroupPairs: perm.UserIdGroupPairs,
+		})
+	}
+	return result
+}
+
+func securityGroupNetworkInterfaces(conn *ec2.EC2, id string) ([]*ec2.NetworkInterface, error) {
+	resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("group-id"),
+				Values: []*string{aws.String(id)},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.NetworkInterfaces, nil
+}
+
+// securityGroupInUseError reports the network interfaces still holding a
+// group that failed to be deleted.
+func securityGroupInUseError(id string, enis []*ec2.NetworkInterface, err error) error {
+	if len(enis) == 0 {
+		return fmt.Errorf("Error deleting Security Group (%s), it may still be referenced by other security groups: %s", id, err)
+	}
+
+	holders := make([]string, 0, len(enis))
+	for _, eni := range enis {
+		holder := aws.StringValue(eni.NetworkInterfaceId)
+		if eni.Attachment != nil && eni.Attachment.InstanceId != nil {
+			holder = fmt.Sprintf("%s (attached to %s)", holder, *eni.Attachment.InstanceId)
+		}
+		holders = append(holders, holder)
+	}
+	sort.Strings(holders)
+
+	return fmt.Errorf("Error deleting Security Group (%s), still in use by network interfaces %s: %s",
+		id, strings.Join(holders, ", "), err)
 }
 
 func resourceAwsSecurityGroupRuleHash(v interface{}) int {
@@ -436,6 +565,11 @@ func resourceAwsSecurityGroupRuleHash(v interface{}) int {
 			buf.WriteString(fmt.Sprintf("%s-", v))
 		}
 	}
+	// Only hashed when set, so that rules without a description keep the
+	// hash they had before descriptions were supported.
+	if v, ok := m["description"]; ok && v.(string) != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
@@ -451,66 +585,62 @@ func resourceAwsSecurityGroupIPPermGather(groupId string, permissions []*ec2.IpP
 			toPort = *v
 		}
 
-		k := fmt.Sprintf("%s-%d-%d", *perm.IpProtocol, fromPort, toPort)
-		m, ok := ruleMap[k]
-		if !ok {
-			m = make(map[string]interface{})
-			ruleMap[k] = m
-		}
+		// Descriptions are set on each source of a permission, so sources
+		// with different descriptions are gathered into different rules.
+		ruleFor := func(description *string) map[string]interface{} {
+			desc := aws.StringValue(description)
+			k := fmt.Sprintf("%s-%d-%d-%s", *perm.IpProtocol, fromPort, toPort, desc)
+			m, ok := ruleMap[k]
+			if !ok {
+				m = make(map[string]interface{})
+				ruleMap[k] = m
+			}
 
-		m["from_port"] = fromPort
-		m["to_port"] = toPort
-		m["protocol"] = *perm.IpProtocol
+			m["from_port"] = fromPort
+			m["to_port"] = toPort
+			m["protocol"] = *perm.IpProtocol
+			if desc != "" {
+				m["description"] = desc
+			}
+			return m
+		}
 
-		if len(perm.IpRanges) > 0 {
+		for _, ip := range perm.IpRanges {
+			m := ruleFor(ip.Description)
 			raw, ok := m["cidr_blocks"]
 			if !ok {
 				raw = make([]string, 0, len(perm.IpRanges))
 			}
-			list := raw.([]string)
-
-			for _, ip := range perm.IpRanges {
-				list = append(list, *ip.CidrIp)
-			}
-
-			m["cidr_blocks"] = list
+			m["cidr_blocks"] = append(raw.([]string), *ip.CidrIp)
 		}
 
-		if len(perm.PrefixListIds) > 0 {
+		for _, pl := range perm.PrefixListIds {
+			m := ruleFor(pl.Description)
 			raw, ok := m["prefix_list_ids"]
 			if !ok {
 				raw = make([]string, 0, len(perm.PrefixListIds))
 			}
-			list := raw.([]string)
-
-			for _, pl := range perm.PrefixListIds {
-				list = append(list, *pl.PrefixListId)
-			}
-
-			m["prefix_list_ids"] = list
+			m["prefix_list_ids"] = append(raw.([]string), *pl.PrefixListId)
 		}
 
 		groups := flattenSecurityGroups(perm.UserIdGroupPairs, ownerId)
 		for i, g := range groups {
+			m := ruleFor(perm.UserIdGroupPairs[i].Description)
 			if *g.GroupId == groupId {
-				groups[i], groups = groups[len(groups)-1], groups[:len(groups)-1]
 				m["self"] = true
+				continue
 			}
-		}
 
-		if len(groups) > 0 {
 			raw, ok := m["security_groups"]
 			if !ok {
 				raw = schema.NewSet(schema.HashString, nil)
 			}
 			list := raw.(*schema.Set)
 
-			for _, g := range groups {
-				if g.GroupName != nil {
-					list.Add(*g.GroupName)
-				} else {
-					list.Add(*g.GroupId)
-				}
+			if g.GroupName != nil {
+				list.Add(*g.GroupName)
+			} else {
+				list.Add(*g.GroupId)
 			}
 
 			m["security_groups"] = list
@@ -537,91 +667,140 @@ func resourceAwsSecurityGroupUpdateRules(
 			n = new(schema.Set)
 		}
 
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
+		conn := meta.(*AWSClient).ec2conn
+		return updateSecurityGroupRules(conn, group, ruleset, o.(*schema.Set), n.(*schema.Set))
+	}
+	return nil
+}
+
+// updateSecurityGroupRules applies the difference between two sets of
+// ingress or egress rules to a security group.
+func updateSecurityGroupRules(conn *ec2.EC2, group *ec2.SecurityGroup, ruleset string, os, ns *schema.Set) error {
+	updateRaw, removeRaw, addRaw := resourceAwsSecurityGroupRuleDescriptionChanges(
+		os.Difference(ns).List(), ns.Difference(os).List())
 
-		remove, err := expandIPPerms(group, os.Difference(ns).List())
-		if err != nil {
-			return err
-		}
-		add, err := expandIPPerms(group, ns.Difference(os).List())
-		if err != nil {
+	remove, err := expandIPPerms(group, removeRaw)
+	if err != nil {
+		return err
+	}
+	add, err := expandIPPerms(group, addRaw)
+	if err != nil {
+		return err
+	}
+	update, err := expandIPPerms(group, updateRaw)
+	if err != nil {
+		return err
+	}
+
+	if len(update) > 0 {
+		log.Printf("[DEBUG] Updating security group %s %s rule descriptions: %#v",
+			*group.GroupId, ruleset, update)
+		if err := updateSecurityGroupRuleDescriptions(conn, group, ruleset, update); err != nil {
 			return err
 		}
+	}
 
-		// TODO: We need to handle partial state better in the in-between
-		// in this update.
+	// TODO: We need to handle partial state better in the in-between
+	// in this update.
 
-		// TODO: It'd be nicer to authorize before removing, but then we have
-		// to deal with complicated unrolling to get individual CIDR blocks
-		// to avoid authorizing already authorized sources. Removing before
-		// adding is easier here, and Terraform should be fast enough to
-		// not have service issues.
+	// TODO: It'd be nicer to authorize before removing, but then we have
+	// to deal with complicated unrolling to get individual CIDR blocks
+	// to avoid authorizing already authorized sources. Removing before
+	// adding is easier here, and Terraform should be fast enough to
+	// not have service issues.
 
-		if len(remove) > 0 || len(add) > 0 {
-			conn := meta.(*AWSClient).ec2conn
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Revoking security group %#v %s rule: %#v",
+			group, ruleset, remove)
 
-			var err error
-			if len(remove) > 0 {
-				log.Printf("[DEBUG] Revoking security group %#v %s rule: %#v",
-					group, ruleset, remove)
+		errs := applySecurityGroupRules(conn, group, ruleset, true, remove)
+		if err := securityGroupRulesError("revoking", ruleset, remove, errs); err != nil {
+			return err
+		}
+	}
 
-				if ruleset == "egress" {
-					req := &ec2.RevokeSecurityGroupEgressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: remove,
-					}
-					_, err = conn.RevokeSecurityGroupEgress(req)
-				} else {
-					req := &ec2.RevokeSecurityGroupIngressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: remove,
-					}
-					if group.VpcId == nil || *group.VpcId == "" {
-						req.GroupId = nil
-						req.GroupName = group.GroupName
-					}
-					_, err = conn.RevokeSecurityGroupIngress(req)
-				}
+	if len(add) > 0 {
+		log.Printf("[DEBUG] Authorizing security group %#v %s rule: %#v",
+			group, ruleset, add)
 
-				if err != nil {
-					return fmt.Errorf(
-						"Error revoking security group %s rules: %s",
-						ruleset, err)
-				}
-			}
+		errs := applySecurityGroupRules(conn, group, ruleset, false, add)
+		if err := securityGroupRulesError("authorizing", ruleset, add, errs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-			if len(add) > 0 {
-				log.Printf("[DEBUG] Authorizing security group %#v %s rule: %#v",
-					group, ruleset, add)
-				// Authorize the new rules
-				if ruleset == "egress" {
-					req := &ec2.AuthorizeSecurityGroupEgressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: add,
-					}
-					_, err = conn.AuthorizeSecurityGroupEgress(req)
-				} else {
-					req := &ec2.AuthorizeSecurityGroupIngressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: add,
-					}
-					if group.VpcId == nil || *group.VpcId == "" {
-						req.GroupId = nil
-						req.GroupName = group.GroupName
-					}
+// resourceAwsSecurityGroupRuleDescriptionChanges picks the rules whose
+// description is the only thing that changed out of the removed and added
+// rules, so that they can be updated in place instead of being revoked and
+// authorized again. The new version of those rules is returned first,
+// followed by the remaining rules to remove and to add.
+func resourceAwsSecurityGroupRuleDescriptionChanges(remove, add []interface{}) ([]interface{}, []interface{}, []interface{}) {
+	removed := make(map[int]int)
+	for i, raw := range remove {
+		removed[resourceAwsSecurityGroupRuleHashWithoutDescription(raw)] = i
+	}
 
-					_, err = conn.AuthorizeSecurityGroupIngress(req)
-				}
+	var update, restRemove, restAdd []interface{}
+	matched := make(map[int]bool)
+	for _, raw := range add {
+		if i, ok := removed[resourceAwsSecurityGroupRuleHashWithoutDescription(raw)]; ok && !matched[i] {
+			matched[i] = true
+			update = append(update, raw)
+			continue
+		}
+		restAdd = append(restAdd, raw)
+	}
+	for i, raw := range remove {
+		if !matched[i] {
+			restRemove = append(restRemove, raw)
+		}
+	}
 
-				if err != nil {
-					return fmt.Errorf(
-						"Error authorizing security group %s rules: %s",
-						ruleset, err)
-				}
-			}
+	return update, restRemove, restAdd
+}
+
+func resourceAwsSecurityGroupRuleHashWithoutDescription(v interface{}) int {
+	m := make(map[string]interface{})
+	for k, v := range v.(map[string]interface{}) {
+		if k != "description" {
+			m[k] = v
 		}
 	}
+	return resourceAwsSecurityGroupRuleHash(m)
+}
+
+// updateSecurityGroupRuleDescriptions replaces the descriptions of existing
+// rules of a security group with the ones of the given permissions. Sources
+// without a description have theirs removed.
+func updateSecurityGroupRuleDescriptions(conn *ec2.EC2, group *ec2.SecurityGroup, ruleType string, perms []*ec2.IpPermission) error {
+	var err error
+	switch ruleType {
+	case "ingress":
+		req := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: perms,
+		}
+		if group.VpcId == nil || *group.VpcId == "" {
+			req.GroupId = nil
+			req.GroupName = group.GroupName
+		}
+		_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(req)
+	case "egress":
+		_, err = conn.UpdateSecurityGroupRuleDescriptionsEgress(&ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: perms,
+		})
+	default:
+		return fmt.Errorf("Security Group Rule must be type 'ingress' or type 'egress'")
+	}
+
+	if err != nil {
+		return fmt.Errorf(
+			"Error updating security group %s rule descriptions: %s",
+			ruleType, err)
+	}
 	return nil
 }
 
@@ -683,10 +862,15 @@ func matchRules(rType string, local []interface{}, remote []map[string]interface
 			selfVal = v.(bool)
 		}
 
+		var localDesc string
+		if v, ok := l["description"]; ok {
+			localDesc = v.(string)
+		}
+
 		// matching against self is required to detect rules that only include self
 		// as the rule. resourceAwsSecurityGroupIPPermGather parses the group out
 		// and replaces it with self if it's ID is found
-		localHash := idHash(rType, l["protocol"].(string), int64(l["to_port"].(int)), int64(l["from_port"].(int)), selfVal)
+		localHash := idHash(rType, l["protocol"].(string), int64(l["to_port"].(int)), int64(l["from_port"].(int)), selfVal, localDesc)
 
 		// loop remote rules, looking for a matching hash
 		for _, r := range remote {
@@ -695,9 +879,14 @@ func matchRules(rType string, local []interface{}, remote []map[string]interface
 				remoteSelfVal = v.(bool)
 			}
 
+			var remoteDesc string
+			if v, ok := r["description"]; ok {
+				remoteDesc = v.(string)
+			}
+
 			// hash this remote rule and compare it for a match consideration with the
 			// local rule we're examining
-			rHash := idHash(rType, r["protocol"].(string), r["to_port"].(int64), r["from_port"].(int64), remoteSelfVal)
+			rHash := idHash(rType, r["protocol"].(string), r["to_port"].(int64), r["from_port"].(int64), remoteSelfVal, remoteDesc)
 			if rHash == localHash {
 				var numExpectedCidrs, numExpectedPrefixLists, numExpectedSGs, numRemoteCidrs, numRemotePrefixLists, numRemoteSGs int
 				var matchingCidrs []string
@@ -919,15 +1108,16 @@ func matchRules(rType string, local []interface{}, remote []map[string]interface
 	return saves
 }
 
-// Creates a unique hash for the type, ports, and protocol, used as a key in
-// maps
-func idHash(rType, protocol string, toPort, fromPort int64, self bool) string {
+// Creates a unique hash for the type, ports, protocol and description, used
+// as a key in maps
+func idHash(rType, protocol string, toPort, fromPort int64, self bool, description string) string {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%s-", rType))
 	buf.WriteString(fmt.Sprintf("%d-", toPort))
 	buf.WriteString(fmt.Sprintf("%d-", fromPort))
 	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(protocol)))
 	buf.WriteString(fmt.Sprintf("%t-", self))
+	buf.WriteString(fmt.Sprintf("%s-", description))
 
 	return fmt.Sprintf("rule-%d", hashcode.String(buf.String()))
 }
@@ -997,70 +1187,6 @@ func sgProtocolIntegers() map[string]int {
 	return protocolIntegers
 }
 
-// The AWS Lambda service creates ENIs behind the scenes and keeps these around for a while
-// which would prevent SGs attached to such ENIs from being destroyed
-func deleteLingeringLambdaENIs(conn *ec2.EC2, d *schema.ResourceData) error {
-	// Here we carefully find the offenders
-	params := &ec2.DescribeNetworkInterfacesInput{
-		Filters: []*ec2.Filter{
-			&ec2.Filter{
-				Name:   aws.String("group-id"),
-				Values: []*string{aws.String(d.Id())},
-			},
-			&ec2.Filter{
-				Name:   aws.String("description"),
-				Values: []*string{aws.String("AWS Lambda VPC ENI: *")},
-			},
-			&ec2.Filter{
-				Name:   aws.String("requester-id"),
-				Values: []*string{aws.String("*:awslambda_*")},
-			},
-		},
-	}
-	networkInterfaceResp, err := conn.DescribeNetworkInterfaces(params)
-	if err != nil {
-		return err
-	}
-
-	// Then we detach and finally delete those
-	v := networkInterfaceResp.NetworkInterfaces
-	for _, eni := range v {
-		if eni.Attachment != nil {
-			detachNetworkInterfaceParams := &ec2.DetachNetworkInterfaceInput{
-				AttachmentId: eni.Attachment.AttachmentId,
-			}
-			_, detachNetworkInterfaceErr := conn.DetachNetworkInterface(detachNetworkInterfaceParams)
-
-			if detachNetworkInterfaceErr != nil {
-				return detachNetworkInterfaceErr
-			}
-
-			log.Printf("[DEBUG] Waiting for ENI (%s) to become detached", *eni.NetworkInterfaceId)
-			stateConf := &resource.StateChangeConf{
-				Pending: []string{"true"},
-				Target:  []string{"false"},
-				Refresh: networkInterfaceAttachedRefreshFunc(conn, *eni.NetworkInterfaceId),
-				Timeout: 10 * time.Minute,
-			}
-			if _, err := stateConf.WaitForState(); err != nil {
-				return fmt.Errorf(
-					"Error waiting for ENI (%s) to become detached: %s", *eni.NetworkInterfaceId, err)
-			}
-		}
-
-		deleteNetworkInterfaceParams := &ec2.DeleteNetworkInterfaceInput{
-			NetworkInterfaceId: eni.NetworkInterfaceId,
-		}
-		_, deleteNetworkInterfaceErr := conn.DeleteNetworkInterface(deleteNetworkInterfaceParams)
-
-		if deleteNetworkInterfaceErr != nil {
-			return deleteNetworkInterfaceErr
-		}
-	}
-
-	return nil
-}
-
 func networkInterfaceAttachedRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
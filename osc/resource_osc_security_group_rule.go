@@ -21,7 +21,11 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSecurityGroupRuleCreate,
 		Read:   resourceAwsSecurityGroupRuleRead,
+		Update: resourceAwsSecurityGroupRuleUpdate,
 		Delete: resourceAwsSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSecurityGroupRuleImportState,
+		},
 
 		SchemaVersion: 2,
 		MigrateState:  resourceAwsSecurityGroupRuleMigrateState,
@@ -89,6 +93,12 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{"cidr_blocks"},
 			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSecurityGroupRuleDescription,
+			},
 		},
 	}
 }
@@ -97,9 +107,6 @@ func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
 
-	awsMutexKV.Lock(sg_id)
-	defer awsMutexKV.Unlock(sg_id)
-
 	sg, err := findResourceSecurityGroup(conn, sg_id)
 	if err != nil {
 		return err
@@ -121,39 +128,14 @@ func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 	ruleType := d.Get("type").(string)
 	isVPC := sg.VpcId != nil && *sg.VpcId != ""
 
-	var autherr error
-	switch ruleType {
-	case "ingress":
-		log.Printf("[DEBUG] Authorizing security group %s %s rule: %s",
-			sg_id, "Ingress", perm)
-
-		req := &ec2.AuthorizeSecurityGroupIngressInput{
-			GroupId:       sg.GroupId,
-			IpPermissions: []*ec2.IpPermission{perm},
-		}
-
-		if !isVPC {
-			req.GroupId = nil
-			req.GroupName = sg.GroupName
-		}
-
-		_, autherr = conn.AuthorizeSecurityGroupIngress(req)
-
-	case "egress":
-		log.Printf("[DEBUG] Authorizing security group %s %s rule: %#v",
-			sg_id, "Egress", perm)
-
-		req := &ec2.AuthorizeSecurityGroupEgressInput{
-			GroupId:       sg.GroupId,
-			IpPermissions: []*ec2.IpPermission{perm},
-		}
-
-		_, autherr = conn.AuthorizeSecurityGroupEgress(req)
-
-	default:
+	if ruleType != "ingress" && ruleType != "egress" {
 		return fmt.Errorf("Security Group Rule must be type 'ingress' or type 'egress'")
 	}
 
+	log.Printf("[DEBUG] Authorizing security group %s %s rule: %s",
+		sg_id, ruleType, perm)
+	autherr := awsSecurityGroupRuleBatcher.Authorize(conn, sg, ruleType, perm)
+
 	if autherr != nil {
 		if awsErr, ok := autherr.(awserr.Error); ok {
 			if awsErr.Code() == "InvalidPermission.Duplicate" {
@@ -262,15 +244,42 @@ func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 	if err := setFromIPPerm(d, sg, p); err != nil {
 		return errwrap.Wrapf("Error setting IP Permission for Security Group Rule: {{err}}", err)
 	}
+	d.Set("description", findRuleDescription(p, rule, isVPC))
 	return nil
 }
 
-func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
 
-	awsMutexKV.Lock(sg_id)
-	defer awsMutexKV.Unlock(sg_id)
+	if d.HasChange("description") {
+		awsMutexKV.Lock(sg_id)
+		defer awsMutexKV.Unlock(sg_id)
+
+		sg, err := findResourceSecurityGroup(conn, sg_id)
+		if err != nil {
+			return err
+		}
+
+		perm, err := expandIPPerm(d, sg)
+		if err != nil {
+			return err
+		}
+
+		ruleType := d.Get("type").(string)
+		log.Printf("[DEBUG] Updating description of security group %s %s rule (%s): %s",
+			sg_id, ruleType, d.Id(), perm)
+		if err := updateSecurityGroupRuleDescriptions(conn, sg, ruleType, []*ec2.IpPermission{perm}); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSecurityGroupRuleRead(d, meta)
+}
+
+func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	sg_id := d.Get("security_group_id").(string)
 
 	sg, err := findResourceSecurityGroup(conn, sg_id)
 	if err != nil {
@@ -282,38 +291,12 @@ func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 	ruleType := d.Get("type").(string)
-	switch ruleType {
-	case "ingress":
-		log.Printf("[DEBUG] Revoking rule (%s) from security group %s:\n%s",
-			"ingress", sg_id, perm)
-		req := &ec2.RevokeSecurityGroupIngressInput{
-			GroupId:       sg.GroupId,
-			IpPermissions: []*ec2.IpPermission{perm},
-		}
-
-		_, err = conn.RevokeSecurityGroupIngress(req)
-
-		if err != nil {
-			return fmt.Errorf(
-				"Error revoking security group %s rules: %s",
-				sg_id, err)
-		}
-	case "egress":
-
-		log.Printf("[DEBUG] Revoking security group %#v %s rule: %#v",
-			sg_id, "egress", perm)
-		req := &ec2.RevokeSecurityGroupEgressInput{
-			GroupId:       sg.GroupId,
-			IpPermissions: []*ec2.IpPermission{perm},
-		}
-
-		_, err = conn.RevokeSecurityGroupEgress(req)
-
-		if err != nil {
-			return fmt.Errorf(
-				"Error revoking security group %s rules: %s",
-				sg_id, err)
-		}
+	log.Printf("[DEBUG] Revoking rule (%s) from security group %s:\n%s",
+		ruleType, sg_id, perm)
+	if err := awsSecurityGroupRuleBatcher.Revoke(conn, sg, ruleType, perm); err != nil {
+		return fmt.Errorf(
+			"Error revoking security group %s rules: %s",
+			sg_id, err)
 	}
 
 	d.SetId("")
@@ -437,6 +420,43 @@ func findRuleMatch(p *ec2.IpPermission, rules []*ec2.IpPermission, isVPC bool) *
 	return rule
 }
 
+// findRuleDescription returns the description of the first source of p found
+// in the matching remote rule. All sources of a rule managed by
+// aws_security_group_rule share the same description.
+func findRuleDescription(p, rule *ec2.IpPermission, isVPC bool) string {
+	for _, ip := range p.IpRanges {
+		for _, rip := range rule.IpRanges {
+			if *ip.CidrIp == *rip.CidrIp {
+				return aws.StringValue(rip.Description)
+			}
+		}
+	}
+
+	for _, pl := range p.PrefixListIds {
+		for _, rpl := range rule.PrefixListIds {
+			if *pl.PrefixListId == *rpl.PrefixListId {
+				return aws.StringValue(rpl.Description)
+			}
+		}
+	}
+
+	for _, ip := range p.UserIdGroupPairs {
+		for _, rip := range rule.UserIdGroupPairs {
+			if isVPC {
+				if aws.StringValue(ip.GroupId) == aws.StringValue(rip.GroupId) {
+					return aws.StringValue(rip.Description)
+				}
+			} else {
+				if aws.StringValue(ip.GroupName) == aws.StringValue(rip.GroupName) {
+					return aws.StringValue(rip.Description)
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
 func ipPermissionIDHash(sg_id, ruleType string, ip *ec2.IpPermission) string {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%s-", sg_id))
@@ -502,6 +522,11 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 	protocol := protocolForValue(d.Get("protocol").(string))
 	perm.IpProtocol = aws.String(protocol)
 
+	var description *string
+	if v, ok := d.GetOk("description"); ok {
+		description = aws.String(v.(string))
+	}
+
 	// build a group map that behaves like a set
 	groups := make(map[string]bool)
 	if raw, ok := d.GetOk("source_security_group_id"); ok {
@@ -531,8 +556,9 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 			}
 
 			perm.UserIdGroupPairs[i] = &ec2.UserIdGroupPair{
-				GroupId: aws.String(id),
-				UserId:  aws.String(ownerId),
+				GroupId:     aws.String(id),
+				UserId:      aws.String(ownerId),
+				Description: description,
 			}
 
 			if sg.VpcId == nil || *sg.VpcId == "" {
@@ -551,7 +577,7 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 			if !ok {
 				return nil, fmt.Errorf("empty element found in cidr_blocks - consider using the compact function")
 			}
-			perm.IpRanges[i] = &ec2.IpRange{CidrIp: aws.String(cidrIP)}
+			perm.IpRanges[i] = &ec2.IpRange{CidrIp: aws.String(cidrIP), Description: description}
 		}
 	}
 
@@ -563,7 +589,7 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 			if !ok {
 				return nil, fmt.Errorf("empty element found in prefix_list_ids - consider using the compact function")
 			}
-			perm.PrefixListIds[i] = &ec2.PrefixListId{PrefixListId: aws.String(prefixListID)}
+			perm.PrefixListIds[i] = &ec2.PrefixListId{PrefixListId: aws.String(prefixListID), Description: description}
 		}
 	}
 
@@ -146,6 +146,61 @@ func TestAccAWSSecurityGroupRule_Ingress_VPC(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroupRule_Description(t *testing.T) {
+	var group ec2.SecurityGroup
+	var ruleId string
+	rInt := acctest.RandInt()
+
+	testRuleDescription := func(description string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if len(group.IpPermissions) != 1 || len(group.IpPermissions[0].IpRanges) != 1 {
+				return fmt.Errorf("Expected a single rule with a single CIDR block: %#v", group.IpPermissions)
+			}
+			if got := aws.StringValue(group.IpPermissions[0].IpRanges[0].Description); got != description {
+				return fmt.Errorf("Bad rule description, expected %q, got %q", description, got)
+			}
+			return nil
+		}
+	}
+
+	testRuleNotRecreated := func(s *terraform.State) error {
+		id := s.RootModule().Resources["aws_security_group_rule.ingress_1"].Primary.ID
+		if ruleId != "" && ruleId != id {
+			return fmt.Errorf("Security Group Rule was recreated: %s became %s", ruleId, id)
+		}
+		ruleId = id
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupRuleDescriptionConfig(rInt, "Web from the office"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.web", &group),
+					resource.TestCheckResourceAttr(
+						"aws_security_group_rule.ingress_1", "description", "Web from the office"),
+					testRuleDescription("Web from the office"),
+					testRuleNotRecreated,
+				),
+			},
+			{
+				Config: testAccAWSSecurityGroupRuleDescriptionConfig(rInt, "Web from the VPN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.web", &group),
+					resource.TestCheckResourceAttr(
+						"aws_security_group_rule.ingress_1", "description", "Web from the VPN"),
+					testRuleDescription("Web from the VPN"),
+					testRuleNotRecreated,
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSecurityGroupRule_Ingress_Protocol(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -696,6 +751,25 @@ func testAccAWSSecurityGroupRuleIngressConfig(rInt int) string {
 	}`, rInt)
 }
 
+func testAccAWSSecurityGroupRuleDescriptionConfig(rInt int, description string) string {
+	return fmt.Sprintf(`
+	resource "aws_security_group" "web" {
+		name = "terraform_test_%d"
+		description = "Used in the terraform acceptance tests"
+	}
+
+	resource "aws_security_group_rule" "ingress_1" {
+		type = "ingress"
+		protocol = "tcp"
+		from_port = 80
+		to_port = 8000
+		cidr_blocks = ["10.0.0.0/8"]
+		description = "%s"
+
+		security_group_id = "${aws_security_group.web.id}"
+	}`, rInt, description)
+}
+
 const testAccAWSSecurityGroupRuleIngress_protocolConfig = `
 resource "aws_vpc" "tftest" {
   cidr_block = "10.0.0.0/16"
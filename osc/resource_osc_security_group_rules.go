@@ -0,0 +1,177 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAwsSecurityGroupRules manages the whole ingress and egress rule
+// sets of an existing security group, e.g. a default one. Unlike
+// aws_security_group_rule, rules added outside of Terraform show up as a diff
+// and are revoked on the next apply. It must not be combined with inline
+// rules or aws_security_group_rule resources on the same group.
+func resourceAwsSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityGroupRulesCreate,
+		Read:   resourceAwsSecurityGroupRulesRead,
+		Update: resourceAwsSecurityGroupRulesUpdate,
+		Delete: resourceAwsSecurityGroupRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ingress": resourceAwsSecurityGroupRulesSetSchema("ingress"),
+			"egress":  resourceAwsSecurityGroupRulesSetSchema("egress"),
+		},
+	}
+}
+
+// The rule sets are the ones of aws_security_group, except that they aren't
+// computed: leaving one out means the group should have no such rules.
+func resourceAwsSecurityGroupRulesSetSchema(ruleset string) *schema.Schema {
+	s := *resourceAwsSecurityGroup().Schema[ruleset]
+	s.Computed = false
+	return &s
+}
+
+func resourceAwsSecurityGroupRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	sg_id := d.Get("security_group_id").(string)
+
+	awsMutexKV.Lock(sg_id)
+	defer awsMutexKV.Unlock(sg_id)
+
+	group, err := findResourceSecurityGroup(conn, sg_id)
+	if err != nil {
+		return err
+	}
+
+	isVPC := group.VpcId != nil && *group.VpcId != ""
+	if !isVPC && d.Get("egress").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("Security group %s is not in a VPC and can't have egress rules", sg_id)
+	}
+
+	// Nothing is in the state yet, so the rules currently in the group are
+	// diffed against the configuration to take ownership of them.
+	for _, ruleset := range []string{"ingress", "egress"} {
+		if ruleset == "egress" && !isVPC {
+			continue
+		}
+
+		local := d.Get(ruleset).(*schema.Set)
+		remote := securityGroupRemoteRuleSet(group, ruleset, local.List())
+		log.Printf("[DEBUG] Taking ownership of %s rules of security group %s", ruleset, sg_id)
+		if err := updateSecurityGroupRules(conn, group, ruleset, remote, local); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(sg_id)
+
+	return resourceAwsSecurityGroupRulesRead(d, meta)
+}
+
+func resourceAwsSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	sgRaw, _, err := SGStateRefreshFunc(conn, d.Id())()
+	if err != nil {
+		return err
+	}
+	if sgRaw == nil {
+		log.Printf("[WARN] Security group %s not found, removing its rules from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	group := sgRaw.(*ec2.SecurityGroup)
+
+	ingressRules := matchRules("ingress", d.Get("ingress").(*schema.Set).List(),
+		resourceAwsSecurityGroupIPPermGather(d.Id(), group.IpPermissions, group.OwnerId))
+	egressRules := matchRules("egress", d.Get("egress").(*schema.Set).List(),
+		resourceAwsSecurityGroupIPPermGather(d.Id(), group.IpPermissionsEgress, group.OwnerId))
+
+	d.Set("security_group_id", group.GroupId)
+	if err := d.Set("ingress", ingressRules); err != nil {
+		return fmt.Errorf("Error setting ingress rules of security group %s: %s", d.Id(), err)
+	}
+	if err := d.Set("egress", egressRules); err != nil {
+		return fmt.Errorf("Error setting egress rules of security group %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityGroupRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	awsMutexKV.Lock(d.Id())
+	defer awsMutexKV.Unlock(d.Id())
+
+	group, err := findResourceSecurityGroup(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	for _, ruleset := range []string{"ingress", "egress"} {
+		if err := resourceAwsSecurityGroupUpdateRules(d, ruleset, meta, group); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSecurityGroupRulesRead(d, meta)
+}
+
+func resourceAwsSecurityGroupRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	awsMutexKV.Lock(d.Id())
+	defer awsMutexKV.Unlock(d.Id())
+
+	group, err := findResourceSecurityGroup(conn, d.Id())
+	if err != nil {
+		if _, ok := err.(securityGroupNotFound); ok {
+			return nil
+		}
+		return err
+	}
+
+	for _, ruleset := range []string{"ingress", "egress"} {
+		rules := d.Get(ruleset).(*schema.Set)
+		if rules.Len() == 0 {
+			continue
+		}
+		empty := schema.NewSet(resourceAwsSecurityGroupRuleHash, nil)
+		if err := updateSecurityGroupRules(conn, group, ruleset, rules, empty); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// securityGroupRemoteRuleSet returns the ingress or egress rules of a group,
+// shaped after the given local rules where they match.
+func securityGroupRemoteRuleSet(group *ec2.SecurityGroup, ruleset string, local []interface{}) *schema.Set {
+	perms := group.IpPermissions
+	if ruleset == "egress" {
+		perms = group.IpPermissionsEgress
+	}
+	remote := matchRules(ruleset, local,
+		resourceAwsSecurityGroupIPPermGather(*group.GroupId, perms, group.OwnerId))
+
+	// Going through ResourceData normalizes the rules to the types used in
+	// the schema, so that they hash like the local ones.
+	rd := resourceAwsSecurityGroupRules().Data(nil)
+	rd.Set(ruleset, remote)
+	return rd.Get(ruleset).(*schema.Set)
+}
@@ -712,3 +712,44 @@ func TestRulesMixedMatching(t *testing.T) {
 		}
 	}
 }
+
+func TestRulesMatching_description(t *testing.T) {
+	local := []interface{}{
+		map[string]interface{}{
+			"from_port":   80,
+			"to_port":     8000,
+			"protocol":    "tcp",
+			"cidr_blocks": []interface{}{"10.0.0.0/16"},
+			"description": "office",
+		},
+	}
+	remote := []map[string]interface{}{
+		map[string]interface{}{
+			"from_port":   int64(80),
+			"to_port":     int64(8000),
+			"protocol":    "tcp",
+			"cidr_blocks": []string{"10.0.0.0/16"},
+			"description": "office",
+		},
+		map[string]interface{}{
+			"from_port":   int64(80),
+			"to_port":     int64(8000),
+			"protocol":    "tcp",
+			"cidr_blocks": []string{"172.8.0.0/16"},
+		},
+	}
+
+	saves := matchRules("ingress", local, remote)
+	if len(saves) != 2 {
+		t.Fatalf("Expected 2 saves, got %d: %#v", len(saves), saves)
+	}
+	if saves[0]["description"] != "office" {
+		t.Fatalf("Expected the local rule to be matched first, got %#v", saves[0])
+	}
+	if _, ok := saves[1]["description"]; ok {
+		t.Fatalf("Expected the remote rule without description, got %#v", saves[1])
+	}
+	if cidrs := saves[1]["cidr_blocks"].([]string); len(cidrs) != 1 || cidrs[0] != "172.8.0.0/16" {
+		t.Fatalf("Bad cidr_blocks of the remote rule: %#v", cidrs)
+	}
+}
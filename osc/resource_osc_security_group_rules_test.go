@@ -0,0 +1,142 @@
+package osc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestSecurityGroupRemoteRuleSet(t *testing.T) {
+	group := &ec2.SecurityGroup{
+		GroupId: aws.String("sg-12345"),
+		OwnerId: aws.String("123456789012"),
+		VpcId:   aws.String("vpc-12345"),
+		IpPermissions: []*ec2.IpPermission{
+			&ec2.IpPermission{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				IpRanges: []*ec2.IpRange{
+					&ec2.IpRange{CidrIp: aws.String("10.0.0.0/8")},
+					&ec2.IpRange{CidrIp: aws.String("192.168.0.0/16")},
+				},
+			},
+		},
+	}
+
+	rd := resourceAwsSecurityGroupRules().Data(nil)
+	rd.Set("ingress", []interface{}{
+		map[string]interface{}{
+			"protocol":    "tcp",
+			"from_port":   443,
+			"to_port":     443,
+			"cidr_blocks": []interface{}{"10.0.0.0/8"},
+		},
+	})
+	local := rd.Get("ingress").(*schema.Set)
+
+	remote := securityGroupRemoteRuleSet(group, "ingress", local.List())
+	if remote.Len() != 2 {
+		t.Fatalf("Expected 2 remote rules, got %d: %#v", remote.Len(), remote.List())
+	}
+
+	// Only the source added outside of the configuration is left to revoke.
+	if n := local.Difference(remote).Len(); n != 0 {
+		t.Fatalf("Expected the local rule to be in the remote set, %d missing", n)
+	}
+	extra := remote.Difference(local).List()
+	if len(extra) != 1 {
+		t.Fatalf("Expected 1 rule to revoke, got: %#v", extra)
+	}
+	cidrs := extra[0].(map[string]interface{})["cidr_blocks"].([]interface{})
+	if len(cidrs) != 1 || cidrs[0] != "192.168.0.0/16" {
+		t.Fatalf("Bad rule to revoke: %#v", extra[0])
+	}
+
+	if egress := securityGroupRemoteRuleSet(group, "egress", nil); egress.Len() != 0 {
+		t.Fatalf("Expected no egress rules, got: %#v", egress.List())
+	}
+}
+
+func TestAccAWSSecurityGroupRules_basic(t *testing.T) {
+	var group ec2.SecurityGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSecurityGroupRulesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.web", &group),
+					resource.TestCheckResourceAttr("aws_security_group_rules.web", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("aws_security_group_rules.web", "egress.#", "1"),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 1, 1),
+					// A rule added outside of Terraform shows up as drift.
+					testAccAWSSecurityGroupAuthorizeGroupIngress(&group, &group),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			resource.TestStep{
+				Config: testAccAWSSecurityGroupRulesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.web", &group),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 1, 1),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_security_group_rules.web",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityGroupRulesCount(group *ec2.SecurityGroup, ingress, egress int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(group.IpPermissions) != ingress {
+			return fmt.Errorf("Expected %d ingress rules, got: %#v", ingress, group.IpPermissions)
+		}
+		if len(group.IpPermissionsEgress) != egress {
+			return fmt.Errorf("Expected %d egress rules, got: %#v", egress, group.IpPermissionsEgress)
+		}
+		return nil
+	}
+}
+
+const testAccAWSSecurityGroupRulesConfig = `
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_security_group" "web" {
+  name = "terraform_acceptance_test_rules"
+  description = "Used in the terraform acceptance tests"
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_security_group_rules" "web" {
+  security_group_id = "${aws_security_group.web.id}"
+
+  ingress {
+    protocol = "tcp"
+    from_port = 443
+    to_port = 443
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+
+  egress {
+    protocol = "tcp"
+    from_port = 443
+    to_port = 443
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+}
+`
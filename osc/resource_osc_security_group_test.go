@@ -254,6 +254,162 @@ func TestResourceAwsSecurityGroupIPPermGather(t *testing.T) {
 	}
 }
 
+func TestResourceAwsSecurityGroupIPPermGather_description(t *testing.T) {
+	raw := []*ec2.IpPermission{
+		&ec2.IpPermission{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(int64(443)),
+			ToPort:     aws.Int64(int64(443)),
+			IpRanges: []*ec2.IpRange{
+				&ec2.IpRange{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("office")},
+				&ec2.IpRange{CidrIp: aws.String("192.168.0.0/16"), Description: aws.String("office")},
+				&ec2.IpRange{CidrIp: aws.String("0.0.0.0/0")},
+			},
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{
+				&ec2.UserIdGroupPair{GroupId: aws.String("sg-22222"), Description: aws.String("load balancer")},
+			},
+		},
+	}
+
+	out := resourceAwsSecurityGroupIPPermGather("sg-11111", raw, aws.String("12345"))
+	if len(out) != 3 {
+		t.Fatalf("Expected 3 rules, got %d: %#v", len(out), out)
+	}
+
+	for _, r := range out {
+		desc, _ := r["description"].(string)
+		switch desc {
+		case "office":
+			if !reflect.DeepEqual(r["cidr_blocks"], []string{"10.0.0.0/8", "192.168.0.0/16"}) {
+				t.Fatalf("Bad cidr_blocks for %q: %#v", desc, r["cidr_blocks"])
+			}
+		case "load balancer":
+			if !r["security_groups"].(*schema.Set).Contains("sg-22222") {
+				t.Fatalf("Bad security_groups for %q: %#v", desc, r["security_groups"])
+			}
+		case "":
+			if _, ok := r["description"]; ok {
+				t.Fatalf("Empty description should not be set: %#v", r)
+			}
+			if !reflect.DeepEqual(r["cidr_blocks"], []string{"0.0.0.0/0"}) {
+				t.Fatalf("Bad cidr_blocks without description: %#v", r["cidr_blocks"])
+			}
+		default:
+			t.Fatalf("Unexpected rule: %#v", r)
+		}
+	}
+}
+
+func TestResourceAwsSecurityGroupRuleHash_description(t *testing.T) {
+	rule := map[string]interface{}{
+		"protocol":    "tcp",
+		"from_port":   80,
+		"to_port":     8000,
+		"self":        false,
+		"cidr_blocks": []interface{}{"10.0.0.0/8"},
+	}
+	withEmpty := map[string]interface{}{}
+	withDesc := map[string]interface{}{}
+	for k, v := range rule {
+		withEmpty[k] = v
+		withDesc[k] = v
+	}
+	withEmpty["description"] = ""
+	withDesc["description"] = "web"
+
+	if resourceAwsSecurityGroupRuleHash(rule) != resourceAwsSecurityGroupRuleHash(withEmpty) {
+		t.Fatalf("An empty description should not change the hash")
+	}
+	if resourceAwsSecurityGroupRuleHash(rule) == resourceAwsSecurityGroupRuleHash(withDesc) {
+		t.Fatalf("A description should change the hash")
+	}
+}
+
+func TestResourceAwsSecurityGroupRuleDescriptionChanges(t *testing.T) {
+	rule := func(toPort int, desc string) map[string]interface{} {
+		return map[string]interface{}{
+			"protocol":    "tcp",
+			"from_port":   80,
+			"to_port":     toPort,
+			"self":        false,
+			"cidr_blocks": []interface{}{"10.0.0.0/8"},
+			"description": desc,
+		}
+	}
+
+	remove := []interface{}{rule(8000, "old"), rule(9000, "")}
+	add := []interface{}{rule(8000, "new"), rule(9001, "")}
+
+	update, restRemove, restAdd := resourceAwsSecurityGroupRuleDescriptionChanges(remove, add)
+
+	if len(update) != 1 || update[0].(map[string]interface{})["description"] != "new" {
+		t.Fatalf("Bad update: %#v", update)
+	}
+	if len(restRemove) != 1 || restRemove[0].(map[string]interface{})["to_port"] != 9000 {
+		t.Fatalf("Bad remove: %#v", restRemove)
+	}
+	if len(restAdd) != 1 || restAdd[0].(map[string]interface{})["to_port"] != 9001 {
+		t.Fatalf("Bad add: %#v", restAdd)
+	}
+}
+
+func TestSecurityGroupGroupPermissions(t *testing.T) {
+	perms := []*ec2.IpPermission{
+		&ec2.IpPermission{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(80),
+			ToPort:     aws.Int64(80),
+			IpRanges:   []*ec2.IpRange{&ec2.IpRange{CidrIp: aws.String("10.0.0.0/8")}},
+		},
+		&ec2.IpPermission{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(443),
+			ToPort:     aws.Int64(443),
+			IpRanges:   []*ec2.IpRange{&ec2.IpRange{CidrIp: aws.String("10.0.0.0/8")}},
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{
+				&ec2.UserIdGroupPair{GroupId: aws.String("sg-22222")},
+			},
+		},
+	}
+
+	result := securityGroupGroupPermissions(perms)
+	if len(result) != 1 {
+		t.Fatalf("Expected 1 permission, got %d: %#v", len(result), result)
+	}
+	if *result[0].FromPort != 443 || len(result[0].IpRanges) != 0 {
+		t.Fatalf("Bad permission: %#v", result[0])
+	}
+	if len(result[0].UserIdGroupPairs) != 1 || *result[0].UserIdGroupPairs[0].GroupId != "sg-22222" {
+		t.Fatalf("Bad group pairs: %#v", result[0].UserIdGroupPairs)
+	}
+}
+
+func TestSecurityGroupInUseError(t *testing.T) {
+	cause := fmt.Errorf("DependencyViolation: resource sg-11111 has a dependent object")
+
+	err := securityGroupInUseError("sg-11111", nil, cause)
+	if !strings.Contains(err.Error(), "referenced by other security groups") {
+		t.Fatalf("Bad error: %s", err)
+	}
+
+	enis := []*ec2.NetworkInterface{
+		&ec2.NetworkInterface{
+			NetworkInterfaceId: aws.String("eni-22222"),
+		},
+		&ec2.NetworkInterface{
+			NetworkInterfaceId: aws.String("eni-11111"),
+			Attachment: &ec2.NetworkInterfaceAttachment{
+				InstanceId: aws.String("i-12345"),
+			},
+		},
+	}
+	err = securityGroupInUseError("sg-11111", enis, cause)
+	expected := "still in use by network interfaces eni-11111 (attached to i-12345), eni-22222"
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("Expected error to contain %q, got: %s", expected, err)
+	}
+}
+
 func TestAccAWSSecurityGroup_basic(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -557,6 +713,93 @@ func TestAccAWSSecurityGroup_Change(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroup_ruleDescription(t *testing.T) {
+	var before, after ec2.SecurityGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_security_group.web",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSecurityGroupConfigRuleDescription("Web from the office"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.web", &before),
+					testAccCheckAWSSecurityGroupRuleDescription(&before, "Web from the office"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSSecurityGroupConfigRuleDescription("Web from the VPN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.web", &after),
+					testAccCheckAWSSecurityGroupRuleDescription(&after, "Web from the VPN"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityGroupRuleDescription(group *ec2.SecurityGroup, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, perms := range [][]*ec2.IpPermission{group.IpPermissions, group.IpPermissionsEgress} {
+			if len(perms) != 1 || len(perms[0].IpRanges) != 1 {
+				return fmt.Errorf("Expected a single rule with a single CIDR block: %#v", perms)
+			}
+			if got := aws.StringValue(perms[0].IpRanges[0].Description); got != description {
+				return fmt.Errorf("Bad rule description, expected %q, got %q", description, got)
+			}
+		}
+		return nil
+	}
+}
+
+func TestAccAWSSecurityGroup_revokeRulesOnDelete(t *testing.T) {
+	var primary, secondary ec2.SecurityGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSecurityGroupConfigRevokeRulesOnDelete,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.primary", &primary),
+					testAccCheckAWSSecurityGroupExists("aws_security_group.secondary", &secondary),
+					resource.TestCheckResourceAttr(
+						"aws_security_group.primary", "revoke_rules_on_delete", "true"),
+					// Close the cycle outside of Terraform, which is what
+					// revoke_rules_on_delete is meant to clean up.
+					testAccAWSSecurityGroupAuthorizeGroupIngress(&primary, &secondary),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityGroupAuthorizeGroupIngress(group, source *ec2.SecurityGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		_, err := conn.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: group.GroupId,
+			IpPermissions: []*ec2.IpPermission{
+				&ec2.IpPermission{
+					IpProtocol: aws.String("tcp"),
+					FromPort:   aws.Int64(22),
+					ToPort:     aws.Int64(22),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{
+						&ec2.UserIdGroupPair{GroupId: source.GroupId},
+					},
+				},
+			},
+		})
+
+		return err
+	}
+}
+
 func TestAccAWSSecurityGroup_generatedName(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -1211,6 +1454,63 @@ resource "aws_security_group" "web" {
 }
 `
 
+func testAccAWSSecurityGroupConfigRuleDescription(description string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_security_group" "web" {
+  name = "terraform_acceptance_test_rule_description"
+  description = "Used in the terraform acceptance tests"
+  vpc_id = "${aws_vpc.foo.id}"
+
+  ingress {
+    protocol = "tcp"
+    from_port = 80
+    to_port = 8000
+    cidr_blocks = ["10.0.0.0/8"]
+    description = "%s"
+  }
+
+  egress {
+    protocol = "tcp"
+    from_port = 80
+    to_port = 8000
+    cidr_blocks = ["10.0.0.0/8"]
+    description = "%s"
+  }
+}
+`, description, description)
+}
+
+const testAccAWSSecurityGroupConfigRevokeRulesOnDelete = `
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_security_group" "primary" {
+  name = "terraform_acceptance_test_revoke_primary"
+  description = "Used in the terraform acceptance tests"
+  vpc_id = "${aws_vpc.foo.id}"
+  revoke_rules_on_delete = true
+}
+
+resource "aws_security_group" "secondary" {
+  name = "terraform_acceptance_test_revoke_secondary"
+  description = "Used in the terraform acceptance tests"
+  vpc_id = "${aws_vpc.foo.id}"
+  revoke_rules_on_delete = true
+
+  ingress {
+    protocol = "tcp"
+    from_port = 22
+    to_port = 22
+    security_groups = ["${aws_security_group.primary.id}"]
+  }
+}
+`
+
 const testAccAWSSecurityGroupConfigSelf = `
 resource "aws_vpc" "foo" {
   cidr_block = "10.1.0.0/16"
@@ -0,0 +1,190 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAwsSnapshotPermissions manages the whole createVolumePermission
+// list of a snapshot, unlike aws_snapshot_create_volume_permission which only
+// adds a single account to it. Permissions granted outside of Terraform show
+// up as a diff and are removed on the next apply.
+func resourceAwsSnapshotPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSnapshotPermissionsCreate,
+		Read:   resourceAwsSnapshotPermissionsRead,
+		Update: resourceAwsSnapshotPermissionsUpdate,
+		Delete: resourceAwsSnapshotPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAwsAccountId,
+				},
+				Set: schema.HashString,
+			},
+			// Permission granted to the "all" group, i.e. anyone can create
+			// a volume from the snapshot.
+			"public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsSnapshotPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	snapshotId := d.Get("snapshot_id").(string)
+
+	// Start from what the snapshot is actually shared with, so that accounts
+	// added outside of Terraform are revoked on the first apply.
+	currentIds, currentPublic, err := describeCreateVolumePermissions(conn, snapshotId)
+	if err != nil {
+		return fmt.Errorf("Error reading createVolumePermission of snapshot %s: %s", snapshotId, err)
+	}
+
+	err = updateCreateVolumePermissions(conn, snapshotId, currentIds, currentPublic,
+		sharingPermissionsAccountIds(d.Get("account_ids")), d.Get("public").(bool))
+	if err != nil {
+		return fmt.Errorf("Error modifying createVolumePermission of snapshot %s: %s", snapshotId, err)
+	}
+
+	d.SetId(snapshotId)
+
+	return resourceAwsSnapshotPermissionsRead(d, meta)
+}
+
+func resourceAwsSnapshotPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	accountIds, public, err := describeCreateVolumePermissions(conn, d.Id())
+	if err != nil {
+		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidSnapshot.NotFound" {
+			log.Printf("[WARN] Snapshot %s not found, removing permissions from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading createVolumePermission of snapshot %s: %s", d.Id(), err)
+	}
+
+	d.Set("snapshot_id", d.Id())
+	d.Set("account_ids", accountIds)
+	d.Set("public", public)
+
+	return nil
+}
+
+func resourceAwsSnapshotPermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	oIds, nIds := d.GetChange("account_ids")
+	oPublic, nPublic := d.GetChange("public")
+
+	err := updateCreateVolumePermissions(conn, d.Id(),
+		sharingPermissionsAccountIds(oIds), oPublic.(bool),
+		sharingPermissionsAccountIds(nIds), nPublic.(bool))
+	if err != nil {
+		return fmt.Errorf("Error modifying createVolumePermission of snapshot %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsSnapshotPermissionsRead(d, meta)
+}
+
+func resourceAwsSnapshotPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	err := updateCreateVolumePermissions(conn, d.Id(),
+		sharingPermissionsAccountIds(d.Get("account_ids")), d.Get("public").(bool), nil, false)
+	if err != nil {
+		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidSnapshot.NotFound" {
+			return nil
+		}
+		return fmt.Errorf("Error removing createVolumePermission of snapshot %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeCreateVolumePermissions(conn *ec2.EC2, snapshotId string) ([]string, bool, error) {
+	attrs, err := conn.DescribeSnapshotAttribute(&ec2.DescribeSnapshotAttributeInput{
+		SnapshotId: aws.String(snapshotId),
+		Attribute:  aws.String("createVolumePermission"),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	accountIds, public := flattenCreateVolumePermissions(attrs.CreateVolumePermissions)
+	return accountIds, public, nil
+}
+
+func updateCreateVolumePermissions(conn *ec2.EC2, snapshotId string, currentIds []string, currentPublic bool, wantedIds []string, wantedPublic bool) error {
+	addIds, removeIds, addPublic, removePublic := sharingPermissionsChanges(
+		currentIds, currentPublic, wantedIds, wantedPublic)
+
+	return modifyCreateVolumePermissions(conn, snapshotId,
+		expandCreateVolumePermissions(addIds, addPublic),
+		expandCreateVolumePermissions(removeIds, removePublic))
+}
+
+func modifyCreateVolumePermissions(conn *ec2.EC2, snapshotId string, add, remove []*ec2.CreateVolumePermission) error {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Modifying createVolumePermission of snapshot %s: adding %d, removing %d",
+		snapshotId, len(add), len(remove))
+	_, err := conn.ModifySnapshotAttribute(&ec2.ModifySnapshotAttributeInput{
+		SnapshotId: aws.String(snapshotId),
+		Attribute:  aws.String("createVolumePermission"),
+		CreateVolumePermission: &ec2.CreateVolumePermissionModifications{
+			Add:    add,
+			Remove: remove,
+		},
+	})
+
+	return err
+}
+
+func expandCreateVolumePermissions(accountIds []string, public bool) []*ec2.CreateVolumePermission {
+	var perms []*ec2.CreateVolumePermission
+	for _, id := range accountIds {
+		perms = append(perms, &ec2.CreateVolumePermission{UserId: aws.String(id)})
+	}
+	if public {
+		perms = append(perms, &ec2.CreateVolumePermission{Group: aws.String("all")})
+	}
+	return perms
+}
+
+func flattenCreateVolumePermissions(perms []*ec2.CreateVolumePermission) ([]string, bool) {
+	accountIds := make([]string, 0, len(perms))
+	public := false
+	for _, p := range perms {
+		if p.UserId != nil {
+			accountIds = append(accountIds, *p.UserId)
+		}
+		if aws.StringValue(p.Group) == "all" {
+			public = true
+		}
+	}
+	return accountIds, public
+}
@@ -0,0 +1,143 @@
+package osc
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSnapshotPermissions_basic(t *testing.T) {
+	var snapshotId string
+	accountId := os.Getenv("AWS_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if accountId == "" {
+				t.Fatal("AWS_ACCOUNT_ID must be set")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSnapshotPermissionsConfig(accountId, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceGetAttr("aws_ebs_snapshot.test", "id", &snapshotId),
+					testAccCheckAWSSnapshotPermissions(&snapshotId, []string{accountId}, true),
+					resource.TestCheckResourceAttr("aws_snapshot_permissions.test", "account_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_snapshot_permissions.test", "public", "true"),
+				),
+			},
+			{
+				ResourceName:      "aws_snapshot_permissions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSnapshotPermissionsConfig(accountId, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSnapshotPermissions(&snapshotId, []string{accountId}, false),
+				),
+			},
+		},
+	})
+}
+
+// Shares the snapshot with another account before the resource exists and
+// checks the first apply revokes it.
+func TestAccAWSSnapshotPermissions_preShared(t *testing.T) {
+	var snapshotId string
+	accountId := os.Getenv("AWS_ACCOUNT_ID")
+	altAccountId := os.Getenv("AWS_ALTERNATE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if accountId == "" || altAccountId == "" {
+				t.Fatal("AWS_ACCOUNT_ID and AWS_ALTERNATE_ACCOUNT_ID must be set")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSnapshotPermissionsConfigSnapshotOnly,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceGetAttr("aws_ebs_snapshot.test", "id", &snapshotId),
+					testAccAWSSnapshotPermissionsShare(&snapshotId, altAccountId),
+				),
+			},
+			{
+				Config: testAccAWSSnapshotPermissionsConfig(accountId, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSnapshotPermissions(&snapshotId, []string{accountId}, false),
+					resource.TestCheckResourceAttr("aws_snapshot_permissions.test", "account_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSnapshotPermissions(snapshotId *string, accountIds []string, public bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		attrs, err := conn.DescribeSnapshotAttribute(&ec2.DescribeSnapshotAttributeInput{
+			SnapshotId: snapshotId,
+			Attribute:  aws.String("createVolumePermission"),
+		})
+		if err != nil {
+			return err
+		}
+
+		actualIds, actualPublic := flattenCreateVolumePermissions(attrs.CreateVolumePermissions)
+		if !reflect.DeepEqual(actualIds, accountIds) {
+			return fmt.Errorf("expected createVolumePermission for %v on %s, got %v", accountIds, *snapshotId, actualIds)
+		}
+		if actualPublic != public {
+			return fmt.Errorf("expected %s public to be %t, got %t", *snapshotId, public, actualPublic)
+		}
+		return nil
+	}
+}
+
+func testAccAWSSnapshotPermissionsConfig(accountId string, public bool) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = "us-west-2a"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = "${aws_ebs_volume.test.id}"
+}
+
+resource "aws_snapshot_permissions" "test" {
+  snapshot_id = "${aws_ebs_snapshot.test.id}"
+  account_ids = ["%s"]
+  public      = %t
+}
+`, accountId, public)
+}
+
+func testAccAWSSnapshotPermissionsShare(snapshotId *string, accountId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		return modifyCreateVolumePermissions(conn, *snapshotId, expandCreateVolumePermissions([]string{accountId}, false), nil)
+	}
+}
+
+const testAccAWSSnapshotPermissionsConfigSnapshotOnly = `
+resource "aws_ebs_volume" "test" {
+  availability_zone = "us-west-2a"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = "${aws_ebs_volume.test.id}"
+}
+`
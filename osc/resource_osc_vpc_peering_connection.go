@@ -36,6 +36,12 @@ func resourceAwsVpcPeeringConnection() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"peer_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -57,7 +63,8 @@ func resourceAwsVpcPeeringConnection() *schema.Resource {
 }
 
 func resourceAwsVPCPeeringCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient)
+	conn := client.ec2conn
 
 	// Create the vpc peering connection
 	createOpts := &ec2.CreateVpcPeeringConnectionInput{
@@ -69,6 +76,16 @@ func resourceAwsVPCPeeringCreate(d *schema.ResourceData, meta interface{}) error
 		createOpts.PeerOwnerId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("peer_region"); ok {
+		// Only the peer region can accept the request.
+		if d.Get("auto_accept").(bool) && v.(string) != client.region {
+			return fmt.Errorf("auto_accept can't be used with a peer_region (%s) other than the region of the provider (%s), "+
+				"accept the VPC Peering Connection with aws_vpc_peering_connection_accepter in the peer region instead",
+				v.(string), client.region)
+		}
+		createOpts.PeerRegion = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] VPC Peering Create options: %#v", createOpts)
 
 	resp, err := conn.CreateVpcPeeringConnection(createOpts)
@@ -87,7 +104,8 @@ func resourceAwsVPCPeeringCreate(d *schema.ResourceData, meta interface{}) error
 		Pending: []string{"initiating-request", "provisioning", "pending"},
 		Target:  []string{"pending-acceptance", "active"},
 		Refresh: resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, d.Id()),
-		Timeout: 1 * time.Minute,
+		// Cross-region requests take longer to reach the peer region.
+		Timeout: 5 * time.Minute,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return errwrap.Wrapf(fmt.Sprintf(
@@ -103,13 +121,16 @@ func resourceAwsVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	conn := client.ec2conn
 
 	pcRaw, status, err := resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, d.Id())()
-	// Allow a failed VPC Peering Connection to fallthrough,
-	// to allow rest of the logic below to do its work.
-	if err != nil && status != "failed" {
+	// Allow a failed, expired or rejected VPC Peering Connection to
+	// fallthrough, to allow rest of the logic below to do its work.
+	if err != nil && !vpcPeeringConnectionFinalStatus[status] {
 		return err
 	}
 
 	if pcRaw == nil {
+		if err != nil {
+			log.Printf("[WARN] %s, removing from state", err)
+		}
 		d.SetId("")
 		return nil
 	}
@@ -139,15 +160,17 @@ func resourceAwsVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Account ID %s, VPC PeerConn Requester %s, Accepter %s",
 		client.accountid, *pc.RequesterVpcInfo.OwnerId, *pc.AccepterVpcInfo.OwnerId)
 
-	if (client.accountid == *pc.AccepterVpcInfo.OwnerId) && (client.accountid != *pc.RequesterVpcInfo.OwnerId) {
+	if vpcPeeringConnectionIsAccepter(client.accountid, client.region, pc) {
 		// We're the accepter
 		d.Set("peer_owner_id", pc.RequesterVpcInfo.OwnerId)
 		d.Set("peer_vpc_id", pc.RequesterVpcInfo.VpcId)
+		d.Set("peer_region", pc.RequesterVpcInfo.Region)
 		d.Set("vpc_id", pc.AccepterVpcInfo.VpcId)
 	} else {
 		// We're the requester
 		d.Set("peer_owner_id", pc.AccepterVpcInfo.OwnerId)
 		d.Set("peer_vpc_id", pc.AccepterVpcInfo.VpcId)
+		d.Set("peer_region", pc.AccepterVpcInfo.Region)
 		d.Set("vpc_id", pc.RequesterVpcInfo.VpcId)
 	}
 
@@ -250,6 +273,10 @@ func resourceAwsVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error
 				return errwrap.Wrapf("Unable to accept VPC Peering Connection: {{err}}", err)
 			}
 			log.Printf("[DEBUG] VPC Peering Connection accept status: %s", status)
+
+			if err := resourceAwsVPCPeeringConnectionWaitActive(conn, d.Id()); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -296,9 +323,10 @@ func resourceAwsVPCPeeringConnectionStateRefreshFunc(conn *ec2.EC2, id string) r
 			}
 		}
 
-		if resp == nil {
+		if resp == nil || len(resp.VpcPeeringConnections) == 0 {
 			// Sometimes AWS just has consistency issues and doesn't see
-			// our instance yet. Return an empty state.
+			// our instance yet, e.g. a request made from another region.
+			// Return an empty state.
 			return nil, "", nil
 		}
 
@@ -306,15 +334,84 @@ func resourceAwsVPCPeeringConnectionStateRefreshFunc(conn *ec2.EC2, id string) r
 
 		// A VPC Peering Connection can exist in a failed state due to
 		// incorrect VPC ID, account ID, or overlapping IP address range,
-		// thus we short circuit before the time out would occur.
-		if pc != nil && *pc.Status.Code == "failed" {
-			return nil, "failed", errors.New(*pc.Status.Message)
+		// or end up expired or rejected by the peer, thus we short circuit
+		// before the time out would occur.
+		if err := vpcPeeringConnectionStatusError(pc); err != nil {
+			return nil, *pc.Status.Code, err
 		}
 
 		return pc, *pc.Status.Code, nil
 	}
 }
 
+// resourceAwsVPCPeeringConnectionWaitActive waits for an accepted VPC Peering
+// Connection to be provisioned on both sides.
+func resourceAwsVPCPeeringConnectionWaitActive(conn *ec2.EC2, id string) error {
+	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to become active.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending-acceptance", "provisioning"},
+		Target:  []string{"active"},
+		Refresh: resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, id),
+		Timeout: 5 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf(fmt.Sprintf(
+			"Error waiting for VPC Peering Connection (%s) to become active: {{err}}",
+			id), err)
+	}
+
+	return nil
+}
+
+// vpcPeeringConnectionFinalStatus lists the states a VPC Peering Connection
+// can't leave but to be deleted.
+var vpcPeeringConnectionFinalStatus = map[string]bool{
+	"expired":  true,
+	"failed":   true,
+	"rejected": true,
+}
+
+// vpcPeeringConnectionStatusError explains why a VPC Peering Connection in a
+// final state can't be used, or returns nil for any other state.
+func vpcPeeringConnectionStatusError(pc *ec2.VpcPeeringConnection) error {
+	if pc == nil || pc.Status == nil {
+		return nil
+	}
+
+	switch aws.StringValue(pc.Status.Code) {
+	case "failed":
+		return errors.New(aws.StringValue(pc.Status.Message))
+	case "expired":
+		return fmt.Errorf("VPC Peering Connection %s expired before being accepted (%s), "+
+			"requests must be accepted within 7 days: create a new one and accept it from the peer account and region",
+			aws.StringValue(pc.VpcPeeringConnectionId), aws.StringValue(pc.Status.Message))
+	case "rejected":
+		var peerVpcId string
+		if pc.AccepterVpcInfo != nil {
+			peerVpcId = aws.StringValue(pc.AccepterVpcInfo.VpcId)
+		}
+		return fmt.Errorf("VPC Peering Connection %s was rejected by the owner of the peer VPC %s (%s): "+
+			"create a new one once the peer is ready to accept it",
+			aws.StringValue(pc.VpcPeeringConnectionId), peerVpcId, aws.StringValue(pc.Status.Message))
+	}
+
+	return nil
+}
+
+// vpcPeeringConnectionIsAccepter tells whether the given account and region
+// are on the accepter side of a VPC Peering Connection. Within a single
+// account, only a cross-region connection has an accepter side of its own.
+func vpcPeeringConnectionIsAccepter(accountid, region string, pc *ec2.VpcPeeringConnection) bool {
+	accepter, requester := pc.AccepterVpcInfo, pc.RequesterVpcInfo
+
+	if aws.StringValue(accepter.OwnerId) != aws.StringValue(requester.OwnerId) {
+		return accountid == aws.StringValue(accepter.OwnerId)
+	}
+
+	return aws.StringValue(accepter.Region) != aws.StringValue(requester.Region) &&
+		region == aws.StringValue(accepter.Region)
+}
+
 func vpcPeeringConnectionOptionsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
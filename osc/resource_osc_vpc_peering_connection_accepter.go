@@ -3,9 +3,13 @@ package osc
 import (
 	"errors"
 	"log"
+	"time"
 
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -43,6 +47,10 @@ func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"peer_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"accepter":  vpcPeeringConnectionOptionsSchema(),
 			"requester": vpcPeeringConnectionOptionsSchema(),
 			"tags":      tagsSchema(),
@@ -51,21 +59,36 @@ func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 }
 
 func resourceAwsVPCPeeringAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
 	id := d.Get("vpc_peering_connection_id").(string)
-	d.SetId(id)
 
-	if err := resourceAwsVPCPeeringRead(d, meta); err != nil {
-		return err
+	// A request made from another region takes a while to show up here.
+	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to be pending acceptance.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"initiating-request", "provisioning"},
+		Target:  []string{"pending-acceptance", "active"},
+		Refresh: resourceAwsVPCPeeringConnectionStateRefreshFunc(client.ec2conn, id),
+		Timeout: 5 * time.Minute,
 	}
-	if d.Id() == "" {
-		return fmt.Errorf("VPC Peering Connection %q not found", id)
+	pcRaw, err := stateConf.WaitForState()
+	if err != nil {
+		if _, ok := err.(*resource.NotFoundError); ok {
+			return fmt.Errorf("VPC Peering Connection %q not found", id)
+		}
+		return errwrap.Wrapf(fmt.Sprintf(
+			"Error waiting for VPC Peering Connection (%s) to be pending acceptance: {{err}}",
+			id), err)
 	}
 
-	// Ensure that this IS as cross-account VPC peering connection.
-	if d.Get("peer_owner_id").(string) == meta.(*AWSClient).accountid {
-		return errors.New("aws_vpc_peering_connection_accepter can only adopt into management cross-account VPC peering connections")
+	// Ensure that this IS a cross-account or cross-region VPC peering
+	// connection, seen from its accepter side.
+	if !vpcPeeringConnectionIsAccepter(client.accountid, client.region, pcRaw.(*ec2.VpcPeeringConnection)) {
+		return errors.New("aws_vpc_peering_connection_accepter can only adopt into management cross-account or cross-region VPC peering connections, " +
+			"using a provider in the account and region of the peer VPC")
 	}
 
+	d.SetId(id)
+
 	return resourceAwsVPCPeeringUpdate(d, meta)
 }
 
@@ -17,7 +17,31 @@ func TestAccAwsVPCPeeringConnectionAccepter_sameAccount(t *testing.T) {
 		Steps: []resource.TestStep{
 			resource.TestStep{
 				Config:      testAccAwsVPCPeeringConnectionAccepterSameAccountConfig,
-				ExpectError: regexp.MustCompile(`aws_vpc_peering_connection_accepter can only adopt into management cross-account VPC peering connections`),
+				ExpectError: regexp.MustCompile(`aws_vpc_peering_connection_accepter can only adopt into management cross-account or cross-region VPC peering connections`),
+			},
+		},
+	})
+}
+
+func TestAccAwsVPCPeeringConnectionAccepter_crossRegion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAwsVPCPeeringConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAwsVPCPeeringConnectionAccepterCrossRegionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"aws_vpc_peering_connection_accepter.peer", "accept_status", "active"),
+					resource.TestCheckResourceAttr(
+						"aws_vpc_peering_connection_accepter.peer", "peer_region", "us-west-2"),
+					resource.TestCheckResourceAttrPair(
+						"aws_vpc_peering_connection_accepter.peer", "peer_vpc_id",
+						"aws_vpc.main", "id"),
+					resource.TestCheckResourceAttr(
+						"aws_vpc_peering_connection.peer", "peer_region", "us-east-1"),
+				),
 			},
 		},
 	})
@@ -76,3 +100,48 @@ resource "aws_vpc_peering_connection_accepter" "peer" {
     }
 }
 `
+
+const testAccAwsVPCPeeringConnectionAccepterCrossRegionConfig = `
+provider "aws" {
+    region = "us-west-2"
+    // Requester's region.
+}
+
+provider "aws" {
+    alias = "peer"
+    region = "us-east-1"
+    // Accepter's region.
+}
+
+resource "aws_vpc" "main" {
+    cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "peer" {
+    provider = "aws.peer"
+    cidr_block = "10.1.0.0/16"
+}
+
+// Requester's side of the connection.
+resource "aws_vpc_peering_connection" "peer" {
+    vpc_id = "${aws_vpc.main.id}"
+    peer_vpc_id = "${aws_vpc.peer.id}"
+    peer_region = "us-east-1"
+    auto_accept = false
+
+    tags {
+      Side = "Requester"
+    }
+}
+
+// Accepter's side of the connection.
+resource "aws_vpc_peering_connection_accepter" "peer" {
+    provider = "aws.peer"
+    vpc_peering_connection_id = "${aws_vpc_peering_connection.peer.id}"
+    auto_accept = true
+
+    tags {
+       Side = "Accepter"
+    }
+}
+`
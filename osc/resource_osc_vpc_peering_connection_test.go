@@ -206,6 +206,96 @@ func TestAccAWSVPCPeeringConnection_failedState(t *testing.T) {
 	})
 }
 
+func TestAccAWSVPCPeeringConnection_peerRegionAutoAccept(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccVpcPeeringConfigPeerRegionAutoAccept,
+				ExpectError: regexp.MustCompile(`auto_accept can't be used with a peer_region`),
+			},
+		},
+	})
+}
+
+func TestVpcPeeringConnectionIsAccepter(t *testing.T) {
+	pc := func(requesterOwner, requesterRegion, accepterOwner, accepterRegion string) *ec2.VpcPeeringConnection {
+		return &ec2.VpcPeeringConnection{
+			RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+				OwnerId: aws.String(requesterOwner),
+				Region:  aws.String(requesterRegion),
+			},
+			AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+				OwnerId: aws.String(accepterOwner),
+				Region:  aws.String(accepterRegion),
+			},
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Account  string
+		Region   string
+		Conn     *ec2.VpcPeeringConnection
+		Accepter bool
+	}{
+		{"same account and region", "111", "eu-west-1", pc("111", "eu-west-1", "111", "eu-west-1"), false},
+		{"cross-account requester", "111", "eu-west-1", pc("111", "eu-west-1", "222", "eu-west-1"), false},
+		{"cross-account accepter", "222", "eu-west-1", pc("111", "eu-west-1", "222", "eu-west-1"), true},
+		{"cross-region requester", "111", "eu-west-1", pc("111", "eu-west-1", "111", "us-east-1"), false},
+		{"cross-region accepter", "111", "us-east-1", pc("111", "eu-west-1", "111", "us-east-1"), true},
+		{"cross-account and region accepter", "222", "us-east-1", pc("111", "eu-west-1", "222", "us-east-1"), true},
+	}
+
+	for _, tc := range cases {
+		if got := vpcPeeringConnectionIsAccepter(tc.Account, tc.Region, tc.Conn); got != tc.Accepter {
+			t.Errorf("%s: expected accepter to be %t, got %t", tc.Name, tc.Accepter, got)
+		}
+	}
+}
+
+func TestVpcPeeringConnectionStatusError(t *testing.T) {
+	pc := func(code, message string) *ec2.VpcPeeringConnection {
+		return &ec2.VpcPeeringConnection{
+			VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			AccepterVpcInfo:        &ec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String("vpc-87654321")},
+			Status: &ec2.VpcPeeringConnectionStateReason{
+				Code:    aws.String(code),
+				Message: aws.String(message),
+			},
+		}
+	}
+
+	for _, code := range []string{"initiating-request", "pending-acceptance", "provisioning", "active", "deleted"} {
+		if err := vpcPeeringConnectionStatusError(pc(code, "")); err != nil {
+			t.Errorf("Expected no error for %s, got: %s", code, err)
+		}
+	}
+
+	cases := map[string]*regexp.Regexp{
+		"failed":   regexp.MustCompile(`^Failed due to incorrect VPC-ID$`),
+		"expired":  regexp.MustCompile(`pcx-12345678 expired before being accepted.*within 7 days`),
+		"rejected": regexp.MustCompile(`pcx-12345678 was rejected by the owner of the peer VPC vpc-87654321`),
+	}
+	messages := map[string]string{
+		"failed":   "Failed due to incorrect VPC-ID",
+		"expired":  "Expired",
+		"rejected": "Rejected",
+	}
+	for code, expected := range cases {
+		err := vpcPeeringConnectionStatusError(pc(code, messages[code]))
+		if err == nil {
+			t.Errorf("Expected an error for %s", code)
+			continue
+		}
+		if !expected.MatchString(err.Error()) {
+			t.Errorf("Unexpected error for %s: %s", code, err)
+		}
+	}
+}
+
 func testAccCheckAWSVpcPeeringConnectionDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -402,3 +492,24 @@ resource "aws_vpc_peering_connection" "foo" {
 	peer_vpc_id = "${aws_vpc.bar.id}"
 }
 `
+
+const testAccVpcPeeringConfigPeerRegionAutoAccept = `
+provider "aws" {
+	region = "us-west-2"
+}
+
+resource "aws_vpc" "foo" {
+	cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "bar" {
+	cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_vpc_peering_connection" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+	peer_vpc_id = "${aws_vpc.bar.id}"
+	peer_region = "us-east-1"
+	auto_accept = true
+}
+`
@@ -5,6 +5,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"log"
+	"net"
 	"sort"
 	"time"
 
@@ -25,6 +26,41 @@ type XmlVpnConnectionConfig struct {
 type XmlIpsecTunnel struct {
 	OutsideAddress string `xml:"vpn_gateway>tunnel_outside_address>ip_address"`
 	PreSharedKey   string `xml:"ike>pre_shared_key"`
+
+	CustomerGatewayOutsideAddress string `xml:"customer_gateway>tunnel_outside_address>ip_address"`
+	CustomerGatewayInsideAddress  string `xml:"customer_gateway>tunnel_inside_address>ip_address"`
+	CustomerGatewayBgpAsn         int    `xml:"customer_gateway>bgp>asn"`
+	VpnGatewayInsideAddress       string `xml:"vpn_gateway>tunnel_inside_address>ip_address"`
+	VpnGatewayBgpAsn              int    `xml:"vpn_gateway>bgp>asn"`
+	VpnGatewayBgpHoldTime         int    `xml:"vpn_gateway>bgp>hold_time"`
+	InsideCidrLength              int    `xml:"vpn_gateway>tunnel_inside_address>network_cidr"`
+
+	IkeAuthenticationProtocol string `xml:"ike>authentication_protocol"`
+	IkeEncryptionProtocol     string `xml:"ike>encryption_protocol"`
+	IkeLifetime               int    `xml:"ike>lifetime"`
+	IkePfsGroup               string `xml:"ike>perfect_forward_secrecy"`
+	IkeMode                   string `xml:"ike>mode"`
+
+	IpsecProtocol               string `xml:"ipsec>protocol"`
+	IpsecAuthenticationProtocol string `xml:"ipsec>authentication_protocol"`
+	IpsecEncryptionProtocol     string `xml:"ipsec>encryption_protocol"`
+	IpsecLifetime               int    `xml:"ipsec>lifetime"`
+	IpsecPfsGroup               string `xml:"ipsec>perfect_forward_secrecy"`
+	IpsecMode                   string `xml:"ipsec>mode"`
+	TcpMssAdjustment            int    `xml:"ipsec>tcp_mss_adjustment"`
+	DpdDelay                    int    `xml:"ipsec>dead_peer_detection>delay"`
+	DpdRetry                    int    `xml:"ipsec>dead_peer_detection>retry"`
+}
+
+// InsideCidr returns the link-local network of the tunnel interfaces, e.g.
+// 169.254.12.0/30, or an empty string if the configuration doesn't have one.
+func (t XmlIpsecTunnel) InsideCidr() string {
+	ip := net.ParseIP(t.VpnGatewayInsideAddress)
+	if ip == nil || t.InsideCidrLength == 0 {
+		return ""
+	}
+	ipnet := net.IPNet{IP: ip, Mask: net.CIDRMask(t.InsideCidrLength, 32)}
+	return fmt.Sprintf("%s/%d", ip.Mask(ipnet.Mask), t.InsideCidrLength)
 }
 
 type TunnelInfo struct {
@@ -111,6 +147,8 @@ func resourceAwsVpnConnection() *schema.Resource {
 				Computed: true,
 			},
 
+			"tunnel": vpnConnectionTunnelSchema(),
+
 			"routes": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -322,6 +360,12 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 			d.Set("tunnel2_address", tunnelInfo.Tunnel2Address)
 			d.Set("tunnel2_preshared_key", tunnelInfo.Tunnel2PreSharedKey)
 		}
+
+		if vpnConfig, err := xmlConfigToVpnConnectionConfig(*vpnConnection.CustomerGatewayConfiguration); err == nil {
+			if err := d.Set("tunnel", flattenVpnConnectionTunnels(vpnConfig.Tunnels)); err != nil {
+				return err
+			}
+		}
 	}
 
 	if err := d.Set("vgw_telemetry", telemetryToMapList(vpnConnection.VgwTelemetry)); err != nil {
@@ -424,15 +468,29 @@ func telemetryToMapList(telemetry []*ec2.VgwTelemetry) []map[string]interface{}
 	return result
 }
 
-func xmlConfigToTunnelInfo(xmlConfig string) (*TunnelInfo, error) {
+// xmlConfigToVpnConnectionConfig parses the customer gateway configuration
+// of a VPN connection, with its tunnels ordered by outside address.
+func xmlConfigToVpnConnectionConfig(xmlConfig string) (*XmlVpnConnectionConfig, error) {
 	var vpnConfig XmlVpnConnectionConfig
 	if err := xml.Unmarshal([]byte(xmlConfig), &vpnConfig); err != nil {
 		return nil, errwrap.Wrapf("Error Unmarshalling XML: {{err}}", err)
 	}
+	if len(vpnConfig.Tunnels) == 0 {
+		return nil, fmt.Errorf("No IPsec tunnel found in XML configuration")
+	}
 
 	// don't expect consistent ordering from the XML
 	sort.Sort(vpnConfig)
 
+	return &vpnConfig, nil
+}
+
+func xmlConfigToTunnelInfo(xmlConfig string) (*TunnelInfo, error) {
+	vpnConfig, err := xmlConfigToVpnConnectionConfig(xmlConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	tunnelInfo := TunnelInfo{
 		Tunnel1Address:      vpnConfig.Tunnels[0].OutsideAddress,
 		Tunnel1PreSharedKey: vpnConfig.Tunnels[0].PreSharedKey,
@@ -446,3 +504,78 @@ func xmlConfigToTunnelInfo(xmlConfig string) (*TunnelInfo, error) {
 	}
 	return &tunnelInfo, nil
 }
+
+// vpnConnectionTunnelSchema is the computed list of IPsec tunnels of a VPN
+// connection, shared with the osc_vpn_connection_device_config data source.
+func vpnConnectionTunnelSchema() *schema.Schema {
+	computedString := &schema.Schema{Type: schema.TypeString, Computed: true}
+	computedInt := &schema.Schema{Type: schema.TypeInt, Computed: true}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"outside_address":                  computedString,
+				"customer_gateway_outside_address": computedString,
+				"preshared_key": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+				"inside_cidr":                     computedString,
+				"vpn_gateway_inside_address":      computedString,
+				"customer_gateway_inside_address": computedString,
+				"bgp_asn":                         computedInt,
+				"bgp_hold_time":                   computedInt,
+				"customer_gateway_bgp_asn":        computedInt,
+				"ike_authentication_protocol":     computedString,
+				"ike_encryption_protocol":         computedString,
+				"ike_lifetime":                    computedInt,
+				"ike_pfs_group":                   computedString,
+				"ike_mode":                        computedString,
+				"ipsec_protocol":                  computedString,
+				"ipsec_authentication_protocol":   computedString,
+				"ipsec_encryption_protocol":       computedString,
+				"ipsec_lifetime":                  computedInt,
+				"ipsec_pfs_group":                 computedString,
+				"ipsec_mode":                      computedString,
+				"tcp_mss_adjustment":              computedInt,
+				"dpd_delay":                       computedInt,
+				"dpd_retry":                       computedInt,
+			},
+		},
+	}
+}
+
+func flattenVpnConnectionTunnels(tunnels []XmlIpsecTunnel) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(tunnels))
+	for _, t := range tunnels {
+		result = append(result, map[string]interface{}{
+			"outside_address":                  t.OutsideAddress,
+			"customer_gateway_outside_address": t.CustomerGatewayOutsideAddress,
+			"preshared_key":                    t.PreSharedKey,
+			"inside_cidr":                      t.InsideCidr(),
+			"vpn_gateway_inside_address":       t.VpnGatewayInsideAddress,
+			"customer_gateway_inside_address":  t.CustomerGatewayInsideAddress,
+			"bgp_asn":                          t.VpnGatewayBgpAsn,
+			"bgp_hold_time":                    t.VpnGatewayBgpHoldTime,
+			"customer_gateway_bgp_asn":         t.CustomerGatewayBgpAsn,
+			"ike_authentication_protocol":      t.IkeAuthenticationProtocol,
+			"ike_encryption_protocol":          t.IkeEncryptionProtocol,
+			"ike_lifetime":                     t.IkeLifetime,
+			"ike_pfs_group":                    t.IkePfsGroup,
+			"ike_mode":                         t.IkeMode,
+			"ipsec_protocol":                   t.IpsecProtocol,
+			"ipsec_authentication_protocol":    t.IpsecAuthenticationProtocol,
+			"ipsec_encryption_protocol":        t.IpsecEncryptionProtocol,
+			"ipsec_lifetime":                   t.IpsecLifetime,
+			"ipsec_pfs_group":                  t.IpsecPfsGroup,
+			"ipsec_mode":                       t.IpsecMode,
+			"tcp_mss_adjustment":               t.TcpMssAdjustment,
+			"dpd_delay":                        t.DpdDelay,
+			"dpd_retry":                        t.DpdRetry,
+		})
+	}
+	return result
+}
@@ -2,6 +2,7 @@ package osc
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -160,6 +161,57 @@ func TestAWSVpnConnection_xmlconfig(t *testing.T) {
 	}
 }
 
+func TestAWSVpnConnection_xmlconfigTunnels(t *testing.T) {
+	vpnConfig, err := xmlConfigToVpnConnectionConfig(testAccAwsVpnFullTunnelXML)
+	if err != nil {
+		t.Fatalf("Error unmarshalling XML: %s", err)
+	}
+	if len(vpnConfig.Tunnels) != 2 {
+		t.Fatalf("Expected 2 tunnels, got %d", len(vpnConfig.Tunnels))
+	}
+
+	expected := XmlIpsecTunnel{
+		OutsideAddress:                "198.51.100.10",
+		PreSharedKey:                  "FIRST_KEY",
+		CustomerGatewayOutsideAddress: "203.0.113.1",
+		CustomerGatewayInsideAddress:  "169.254.44.6",
+		CustomerGatewayBgpAsn:         65000,
+		VpnGatewayInsideAddress:       "169.254.44.5",
+		VpnGatewayBgpAsn:              50624,
+		VpnGatewayBgpHoldTime:         30,
+		InsideCidrLength:              30,
+		IkeAuthenticationProtocol:     "sha1",
+		IkeEncryptionProtocol:         "aes-128-cbc",
+		IkeLifetime:                   28800,
+		IkePfsGroup:                   "group2",
+		IkeMode:                       "main",
+		IpsecProtocol:                 "esp",
+		IpsecAuthenticationProtocol:   "hmac-sha1-96",
+		IpsecEncryptionProtocol:       "aes-128-cbc",
+		IpsecLifetime:                 3600,
+		IpsecPfsGroup:                 "group2",
+		IpsecMode:                     "tunnel",
+		TcpMssAdjustment:              1387,
+		DpdDelay:                      10,
+		DpdRetry:                      3,
+	}
+	if !reflect.DeepEqual(vpnConfig.Tunnels[0], expected) {
+		t.Fatalf("Bad first tunnel.\nExpected: %#v\nGot: %#v", expected, vpnConfig.Tunnels[0])
+	}
+	if cidr := vpnConfig.Tunnels[0].InsideCidr(); cidr != "169.254.44.4/30" {
+		t.Fatalf("Bad inside CIDR of first tunnel: %s", cidr)
+	}
+	if vpnConfig.Tunnels[1].OutsideAddress != "198.51.100.20" {
+		t.Fatalf("Second address from tunnel XML was incorrect: %s", vpnConfig.Tunnels[1].OutsideAddress)
+	}
+}
+
+func TestAWSVpnConnection_xmlconfigNoTunnel(t *testing.T) {
+	if _, err := xmlConfigToTunnelInfo(`<vpn_connection id="vpn-abc123"></vpn_connection>`); err == nil {
+		t.Fatalf("Expected an error for a configuration without tunnels")
+	}
+}
+
 const testAccAwsVpnConnectionConfig = `
 resource "aws_vpn_gateway" "vpn_gateway" {
   tags {
@@ -228,3 +280,119 @@ const testAccAwsVpnTunnelInfoXML = `
   </ipsec_tunnel>
 </vpn_connection>
 `
+
+// Complete configuration as returned for a BGP VPN connection, with the
+// tunnels out of order.
+const testAccAwsVpnFullTunnelXML = `
+<vpn_connection id="vpn-abc123">
+  <customer_gateway_id>cgw-abc123</customer_gateway_id>
+  <vpn_gateway_id>vgw-abc123</vpn_gateway_id>
+  <vpn_connection_type>ipsec.1</vpn_connection_type>
+  <ipsec_tunnel>
+    <customer_gateway>
+      <tunnel_outside_address>
+        <ip_address>203.0.113.1</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.45.2</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+      <bgp>
+        <asn>65000</asn>
+        <hold_time>30</hold_time>
+      </bgp>
+    </customer_gateway>
+    <vpn_gateway>
+      <tunnel_outside_address>
+        <ip_address>198.51.100.20</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.45.1</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+      <bgp>
+        <asn>50624</asn>
+        <hold_time>30</hold_time>
+      </bgp>
+    </vpn_gateway>
+    <ike>
+      <authentication_protocol>sha1</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>28800</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>main</mode>
+      <pre_shared_key>SECOND_KEY</pre_shared_key>
+    </ike>
+    <ipsec>
+      <protocol>esp</protocol>
+      <authentication_protocol>hmac-sha1-96</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>3600</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>tunnel</mode>
+      <clear_df_bit>true</clear_df_bit>
+      <fragmentation_before_encryption>true</fragmentation_before_encryption>
+      <tcp_mss_adjustment>1387</tcp_mss_adjustment>
+      <dead_peer_detection>
+        <delay>10</delay>
+        <retry>3</retry>
+      </dead_peer_detection>
+    </ipsec>
+  </ipsec_tunnel>
+  <ipsec_tunnel>
+    <customer_gateway>
+      <tunnel_outside_address>
+        <ip_address>203.0.113.1</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.44.6</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+      <bgp>
+        <asn>65000</asn>
+        <hold_time>30</hold_time>
+      </bgp>
+    </customer_gateway>
+    <vpn_gateway>
+      <tunnel_outside_address>
+        <ip_address>198.51.100.10</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.44.5</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+      <bgp>
+        <asn>50624</asn>
+        <hold_time>30</hold_time>
+      </bgp>
+    </vpn_gateway>
+    <ike>
+      <authentication_protocol>sha1</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>28800</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>main</mode>
+      <pre_shared_key>FIRST_KEY</pre_shared_key>
+    </ike>
+    <ipsec>
+      <protocol>esp</protocol>
+      <authentication_protocol>hmac-sha1-96</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>3600</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>tunnel</mode>
+      <clear_df_bit>true</clear_df_bit>
+      <fragmentation_before_encryption>true</fragmentation_before_encryption>
+      <tcp_mss_adjustment>1387</tcp_mss_adjustment>
+      <dead_peer_detection>
+        <delay>10</delay>
+        <retry>3</retry>
+      </dead_peer_detection>
+    </ipsec>
+  </ipsec_tunnel>
+</vpn_connection>
+`
@@ -0,0 +1,113 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAwsVpnGatewayRoutePropagation propagates the routes of a VPN
+// gateway to a route table managed elsewhere. The route table ignores the
+// propagations it doesn't list in its own propagating_vgws.
+func resourceAwsVpnGatewayRoutePropagation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpnGatewayRoutePropagationEnable,
+		Read:   resourceAwsVpnGatewayRoutePropagationRead,
+		Delete: resourceAwsVpnGatewayRoutePropagationDisable,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpnGatewayRoutePropagationImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsVpnGatewayRoutePropagationEnable(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	gwID := d.Get("vpn_gateway_id").(string)
+	rtID := d.Get("route_table_id").(string)
+
+	if err := enableVgwRoutePropagation(conn, rtID, gwID); err != nil {
+		return fmt.Errorf("Error enabling VGW propagation of %s to route table %s: %s", gwID, rtID, err)
+	}
+
+	d.SetId(vpnGatewayRoutePropagationID(rtID, gwID))
+	return resourceAwsVpnGatewayRoutePropagationRead(d, meta)
+}
+
+func resourceAwsVpnGatewayRoutePropagationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	gwID := d.Get("vpn_gateway_id").(string)
+	rtID := d.Get("route_table_id").(string)
+
+	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, rtID)()
+	if err != nil {
+		return err
+	}
+	if rtRaw == nil {
+		log.Printf("[WARN] Route table %s not found, removing VGW propagation %s from state", rtID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	for _, vgw := range rtRaw.(*ec2.RouteTable).PropagatingVgws {
+		if aws.StringValue(vgw.GatewayId) == gwID {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] VGW %s no longer propagates to route table %s, removing from state", gwID, rtID)
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsVpnGatewayRoutePropagationDisable(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	gwID := d.Get("vpn_gateway_id").(string)
+	rtID := d.Get("route_table_id").(string)
+
+	log.Printf("[INFO] Disabling VGW propagation of %s to route table %s", gwID, rtID)
+	_, err := conn.DisableVgwRoutePropagation(&ec2.DisableVgwRoutePropagationInput{
+		RouteTableId: aws.String(rtID),
+		GatewayId:    aws.String(gwID),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidRouteTableID.NotFound", "") || isAWSErr(err, "InvalidVpnGatewayID.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error disabling VGW propagation of %s to route table %s: %s", gwID, rtID, err)
+	}
+
+	return nil
+}
+
+func resourceAwsVpnGatewayRoutePropagationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "_")
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "rtb-") || !strings.HasPrefix(parts[1], "vgw-") {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ROUTETABLEID_VPNGATEWAYID", d.Id())
+	}
+
+	d.Set("route_table_id", parts[0])
+	d.Set("vpn_gateway_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func vpnGatewayRoutePropagationID(rtID, gwID string) string {
+	return fmt.Sprintf("%s_%s", rtID, gwID)
+}
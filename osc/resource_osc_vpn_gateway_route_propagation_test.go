@@ -0,0 +1,141 @@
+package osc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsVpnGatewayRoutePropagationImportState(t *testing.T) {
+	d := resourceAwsVpnGatewayRoutePropagation().Data(nil)
+	d.SetId("rtb-12345678_vgw-87654321")
+	if _, err := resourceAwsVpnGatewayRoutePropagationImportState(d, nil); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if v := d.Get("route_table_id").(string); v != "rtb-12345678" {
+		t.Fatalf("Bad route_table_id: %s", v)
+	}
+	if v := d.Get("vpn_gateway_id").(string); v != "vgw-87654321" {
+		t.Fatalf("Bad vpn_gateway_id: %s", v)
+	}
+
+	for _, id := range []string{"rtb-12345678", "vgw-87654321_rtb-12345678", "rtb-12345678_vgw-87654321_x"} {
+		d := resourceAwsVpnGatewayRoutePropagation().Data(nil)
+		d.SetId(id)
+		if _, err := resourceAwsVpnGatewayRoutePropagationImportState(d, nil); err == nil {
+			t.Errorf("Expected an error for %q", id)
+		}
+	}
+}
+
+func TestAccAWSVpnGatewayRoutePropagation_basic(t *testing.T) {
+	var rt ec2.RouteTable
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckVpnGatewayDestroy,
+			testAccCheckRouteTableDestroy,
+		),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSVpnGatewayRoutePropagationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists("aws_route_table.foo", &rt),
+					testAccCheckRouteTablePropagatingVgws(&rt, "aws_vpn_gateway.foo"),
+					// The route table ignores the propagation it doesn't own.
+					resource.TestCheckResourceAttr("aws_route_table.foo", "propagating_vgws.#", "0"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_vpn_gateway_route_propagation.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				// The imported route table doesn't claim the propagation.
+				ResourceName: "aws_route_table.foo",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					for _, is := range states {
+						if is.ID != *rt.RouteTableId {
+							continue
+						}
+						if v := is.Attributes["propagating_vgws.#"]; v != "0" {
+							return fmt.Errorf("Expected no imported propagating_vgws, got %s", v)
+						}
+						return nil
+					}
+					return fmt.Errorf("Route table %s not imported: %#v", *rt.RouteTableId, states)
+				},
+			},
+			resource.TestStep{
+				Config: testAccAWSVpnGatewayRoutePropagationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists("aws_route_table.foo", &rt),
+					testAccCheckRouteTablePropagatingVgws(&rt, "aws_vpn_gateway.foo"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSVpnGatewayRoutePropagationConfigRemoved,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists("aws_route_table.foo", &rt),
+					testAccCheckRouteTablePropagatingVgws(&rt),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRouteTablePropagatingVgws(rt *ec2.RouteTable, gateways ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(rt.PropagatingVgws) != len(gateways) {
+			return fmt.Errorf("Expected %d propagating VGWs, got: %#v", len(gateways), rt.PropagatingVgws)
+		}
+
+		for _, n := range gateways {
+			rs, ok := s.RootModule().Resources[n]
+			if !ok {
+				return fmt.Errorf("Not found: %s", n)
+			}
+
+			found := false
+			for _, vgw := range rt.PropagatingVgws {
+				if aws.StringValue(vgw.GatewayId) == rs.Primary.ID {
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("%s doesn't propagate to the route table: %#v", rs.Primary.ID, rt.PropagatingVgws)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccAWSVpnGatewayRoutePropagationConfigRemoved = `
+resource "aws_vpc" "foo" {
+	cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_vpn_gateway" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_route_table" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+}
+`
+
+const testAccAWSVpnGatewayRoutePropagationConfig = testAccAWSVpnGatewayRoutePropagationConfigRemoved + `
+resource "aws_vpn_gateway_route_propagation" "foo" {
+	vpn_gateway_id = "${aws_vpn_gateway.foo.id}"
+	route_table_id = "${aws_route_table.foo.id}"
+}
+`
@@ -0,0 +1,237 @@
+package osc
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// Maximum number of sources (CIDR blocks, security groups and prefix lists)
+// authorized or revoked in a single API call.
+const securityGroupRulesBatchSize = 100
+
+// Rules of a security group managed through separate osc_security_group_rule
+// resources are created and destroyed concurrently by Terraform. Instead of
+// issuing one call per rule, the changes made to a group within a short window
+// are coalesced into batched calls.
+var awsSecurityGroupRuleBatcher = newSecurityGroupRuleBatcher(500*time.Millisecond, applySecurityGroupRules)
+
+type securityGroupRuleApplyFunc func(conn *ec2.EC2, group *ec2.SecurityGroup, ruleType string, revoke bool, perms []*ec2.IpPermission) []error
+
+type securityGroupRuleBatcher struct {
+	sync.Mutex
+	delay   time.Duration
+	apply   securityGroupRuleApplyFunc
+	pending map[string]*securityGroupRuleBatch
+}
+
+type securityGroupRuleBatch struct {
+	conn     *ec2.EC2
+	group    *ec2.SecurityGroup
+	ruleType string
+	revoke   bool
+	perms    []*ec2.IpPermission
+	results  []chan error
+}
+
+func newSecurityGroupRuleBatcher(delay time.Duration, apply securityGroupRuleApplyFunc) *securityGroupRuleBatcher {
+	return &securityGroupRuleBatcher{
+		delay:   delay,
+		apply:   apply,
+		pending: make(map[string]*securityGroupRuleBatch),
+	}
+}
+
+// Authorize adds a rule to the next batch of the group and waits for the
+// batch to be applied. The returned error only concerns the given rule.
+func (b *securityGroupRuleBatcher) Authorize(conn *ec2.EC2, group *ec2.SecurityGroup, ruleType string, perm *ec2.IpPermission) error {
+	return b.submit(conn, group, ruleType, false, perm)
+}
+
+// Revoke removes a rule with the next batch of the group and waits for the
+// batch to be applied. The returned error only concerns the given rule.
+func (b *securityGroupRuleBatcher) Revoke(conn *ec2.EC2, group *ec2.SecurityGroup, ruleType string, perm *ec2.IpPermission) error {
+	return b.submit(conn, group, ruleType, true, perm)
+}
+
+func (b *securityGroupRuleBatcher) submit(conn *ec2.EC2, group *ec2.SecurityGroup, ruleType string, revoke bool, perm *ec2.IpPermission) error {
+	result := make(chan error, 1)
+	key := fmt.Sprintf("%s-%s-%t", aws.StringValue(group.GroupId), ruleType, revoke)
+
+	b.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &securityGroupRuleBatch{
+			conn:     conn,
+			group:    group,
+			ruleType: ruleType,
+			revoke:   revoke,
+		}
+		b.pending[key] = batch
+		time.AfterFunc(b.delay, func() { b.flush(key) })
+	}
+	batch.perms = append(batch.perms, perm)
+	batch.results = append(batch.results, result)
+	b.Unlock()
+
+	return <-result
+}
+
+func (b *securityGroupRuleBatcher) flush(key string) {
+	b.Lock()
+	batch := b.pending[key]
+	delete(b.pending, key)
+	b.Unlock()
+
+	groupId := aws.StringValue(batch.group.GroupId)
+	awsMutexKV.Lock(groupId)
+	errs := b.apply(batch.conn, batch.group, batch.ruleType, batch.revoke, batch.perms)
+	awsMutexKV.Unlock(groupId)
+
+	for i, result := range batch.results {
+		result <- errs[i]
+	}
+}
+
+// applySecurityGroupRules authorizes or revokes rules of a security group in
+// chunks of securityGroupRulesBatchSize sources. When a chunk fails, its rules
+// are applied one by one so that the error of each rule is known. The
+// returned slice holds the error of each of the given rules.
+func applySecurityGroupRules(conn *ec2.EC2, group *ec2.SecurityGroup, ruleType string, revoke bool, perms []*ec2.IpPermission) []error {
+	errs := make([]error, len(perms))
+
+	offset := 0
+	for _, chunk := range chunkSecurityGroupRules(perms, securityGroupRulesBatchSize) {
+		log.Printf("[DEBUG] Applying %d %s rules to security group %s (revoke: %t)",
+			len(chunk), ruleType, aws.StringValue(group.GroupId), revoke)
+		err := modifySecurityGroupRules(conn, group, ruleType, revoke, chunk)
+		if err != nil && len(chunk) > 1 {
+			log.Printf("[WARN] Batch of %d %s rules failed on security group %s, applying them one by one: %s",
+				len(chunk), ruleType, aws.StringValue(group.GroupId), err)
+			for i, perm := range chunk {
+				errs[offset+i] = modifySecurityGroupRules(conn, group, ruleType, revoke, []*ec2.IpPermission{perm})
+			}
+		} else {
+			for i := range chunk {
+				errs[offset+i] = err
+			}
+		}
+		offset += len(chunk)
+	}
+
+	return errs
+}
+
+func modifySecurityGroupRules(conn *ec2.EC2, group *ec2.SecurityGroup, ruleType string, revoke bool, perms []*ec2.IpPermission) error {
+	isVPC := group.VpcId != nil && *group.VpcId != ""
+
+	var err error
+	switch {
+	case ruleType == "egress" && revoke:
+		_, err = conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: perms,
+		})
+	case ruleType == "egress":
+		_, err = conn.AuthorizeSecurityGroupEgress(&ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: perms,
+		})
+	case revoke:
+		req := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: perms,
+		}
+		if !isVPC {
+			req.GroupId = nil
+			req.GroupName = group.GroupName
+		}
+		_, err = conn.RevokeSecurityGroupIngress(req)
+	default:
+		req := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: perms,
+		}
+		if !isVPC {
+			req.GroupId = nil
+			req.GroupName = group.GroupName
+		}
+		_, err = conn.AuthorizeSecurityGroupIngress(req)
+	}
+
+	return err
+}
+
+// chunkSecurityGroupRules splits rules into chunks holding at most size
+// sources. A rule with more sources than that gets a chunk of its own.
+func chunkSecurityGroupRules(perms []*ec2.IpPermission, size int) [][]*ec2.IpPermission {
+	var chunks [][]*ec2.IpPermission
+	var chunk []*ec2.IpPermission
+	count := 0
+	for _, perm := range perms {
+		n := ipPermissionSourceCount(perm)
+		if len(chunk) > 0 && count+n > size {
+			chunks = append(chunks, chunk)
+			chunk = nil
+			count = 0
+		}
+		chunk = append(chunk, perm)
+		count += n
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+func ipPermissionSourceCount(perm *ec2.IpPermission) int {
+	n := len(perm.IpRanges) + len(perm.PrefixListIds) + len(perm.UserIdGroupPairs)
+	if n == 0 {
+		return 1
+	}
+	return n
+}
+
+// securityGroupRulesError gathers the errors returned by
+// applySecurityGroupRules into a single error naming each failed rule.
+func securityGroupRulesError(action, ruleType string, perms []*ec2.IpPermission, errs []error) error {
+	var msgs []string
+	for i, err := range errs {
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("  * %s: %s", ipPermissionSummary(perms[i]), err))
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("Error %s security group %s rules, %d of %d failed:\n%s",
+		action, ruleType, len(msgs), len(perms), strings.Join(msgs, "\n"))
+}
+
+// ipPermissionSummary describes a rule as protocol, port range and sources,
+// e.g. "tcp 443-443 from 10.0.0.0/8, sg-12345".
+func ipPermissionSummary(perm *ec2.IpPermission) string {
+	var sources []string
+	for _, r := range perm.IpRanges {
+		sources = append(sources, aws.StringValue(r.CidrIp))
+	}
+	for _, p := range perm.PrefixListIds {
+		sources = append(sources, aws.StringValue(p.PrefixListId))
+	}
+	for _, p := range perm.UserIdGroupPairs {
+		if p.GroupId != nil {
+			sources = append(sources, *p.GroupId)
+		} else {
+			sources = append(sources, aws.StringValue(p.GroupName))
+		}
+	}
+
+	return fmt.Sprintf("%s %d-%d from %s", aws.StringValue(perm.IpProtocol),
+		aws.Int64Value(perm.FromPort), aws.Int64Value(perm.ToPort), strings.Join(sources, ", "))
+}
@@ -0,0 +1,125 @@
+package osc
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func testSecurityGroupRulePerm(port int64, cidrs ...string) *ec2.IpPermission {
+	perm := &ec2.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(port),
+		ToPort:     aws.Int64(port),
+	}
+	for _, cidr := range cidrs {
+		perm.IpRanges = append(perm.IpRanges, &ec2.IpRange{CidrIp: aws.String(cidr)})
+	}
+	return perm
+}
+
+func TestChunkSecurityGroupRules(t *testing.T) {
+	perms := []*ec2.IpPermission{
+		testSecurityGroupRulePerm(22, "10.0.0.0/8", "10.1.0.0/16"),
+		testSecurityGroupRulePerm(80, "10.0.0.0/8"),
+		testSecurityGroupRulePerm(443, "10.0.0.0/8", "10.1.0.0/16", "10.2.0.0/16", "10.3.0.0/16"),
+		testSecurityGroupRulePerm(8080, "10.0.0.0/8"),
+	}
+
+	chunks := chunkSecurityGroupRules(perms, 3)
+	expected := [][]int64{{22, 80}, {443}, {8080}}
+	if len(chunks) != len(expected) {
+		t.Fatalf("Expected %d chunks, got %d: %#v", len(expected), len(chunks), chunks)
+	}
+	for i, chunk := range chunks {
+		if len(chunk) != len(expected[i]) {
+			t.Fatalf("Bad chunk %d: %#v", i, chunk)
+		}
+		for j, perm := range chunk {
+			if *perm.FromPort != expected[i][j] {
+				t.Fatalf("Bad chunk %d: expected port %d, got %d", i, expected[i][j], *perm.FromPort)
+			}
+		}
+	}
+
+	if chunks := chunkSecurityGroupRules(nil, 3); len(chunks) != 0 {
+		t.Fatalf("Expected no chunks, got %#v", chunks)
+	}
+}
+
+func TestSecurityGroupRulesError(t *testing.T) {
+	perms := []*ec2.IpPermission{
+		testSecurityGroupRulePerm(22, "10.0.0.0/8"),
+		testSecurityGroupRulePerm(443, "10.0.0.0/8", "10.1.0.0/16"),
+	}
+
+	if err := securityGroupRulesError("authorizing", "ingress", perms, make([]error, 2)); err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
+	}
+
+	err := securityGroupRulesError("authorizing", "ingress", perms,
+		[]error{nil, fmt.Errorf("InvalidPermission.Duplicate")})
+	if err == nil {
+		t.Fatalf("Expected an error")
+	}
+	expected := "1 of 2 failed:\n  * tcp 443-443 from 10.0.0.0/8, 10.1.0.0/16: InvalidPermission.Duplicate"
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("Expected error to contain %q, got: %s", expected, err)
+	}
+}
+
+func TestSecurityGroupRuleBatcher(t *testing.T) {
+	var mu sync.Mutex
+	var calls [][]*ec2.IpPermission
+	apply := func(conn *ec2.EC2, group *ec2.SecurityGroup, ruleType string, revoke bool, perms []*ec2.IpPermission) []error {
+		mu.Lock()
+		calls = append(calls, perms)
+		mu.Unlock()
+
+		errs := make([]error, len(perms))
+		for i, perm := range perms {
+			if *perm.FromPort == 443 {
+				errs[i] = fmt.Errorf("rule %d failed", *perm.FromPort)
+			}
+		}
+		return errs
+	}
+
+	batcher := newSecurityGroupRuleBatcher(50*time.Millisecond, apply)
+	group := &ec2.SecurityGroup{GroupId: aws.String("sg-12345")}
+
+	ports := []int64{22, 80, 443}
+	errs := make([]error, len(ports))
+	var wg sync.WaitGroup
+	for i, port := range ports {
+		wg.Add(1)
+		go func(i int, port int64) {
+			defer wg.Done()
+			errs[i] = batcher.Authorize(nil, group, "ingress", testSecurityGroupRulePerm(port, "10.0.0.0/8"))
+		}(i, port)
+	}
+	wg.Wait()
+
+	if len(calls) != 1 || len(calls[0]) != 3 {
+		t.Fatalf("Expected the rules to be applied in a single batch, got: %#v", calls)
+	}
+	if errs[0] != nil || errs[1] != nil {
+		t.Fatalf("Expected no error for ports 22 and 80, got: %v", errs)
+	}
+	if errs[2] == nil || errs[2].Error() != "rule 443 failed" {
+		t.Fatalf("Expected the error of port 443, got: %v", errs[2])
+	}
+
+	// A revoke is never batched with authorizations.
+	if err := batcher.Revoke(nil, group, "ingress", testSecurityGroupRulePerm(22, "10.0.0.0/8")); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(calls) != 2 || len(calls[1]) != 1 {
+		t.Fatalf("Expected a second batch, got: %#v", calls)
+	}
+}
@@ -0,0 +1,42 @@
+package osc
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// sharingPermissionsChanges computes what has to be granted and revoked to
+// go from the current sharing of an image or a snapshot (the accounts it is
+// shared with and whether it is public) to the wanted one.
+func sharingPermissionsChanges(currentIds []string, currentPublic bool, wantedIds []string, wantedPublic bool) (addIds, removeIds []string, addPublic, removePublic bool) {
+	current := make(map[string]bool, len(currentIds))
+	for _, id := range currentIds {
+		current[id] = true
+	}
+	wanted := make(map[string]bool, len(wantedIds))
+	for _, id := range wantedIds {
+		wanted[id] = true
+	}
+
+	for _, id := range wantedIds {
+		if !current[id] {
+			addIds = append(addIds, id)
+		}
+	}
+	for _, id := range currentIds {
+		if !wanted[id] {
+			removeIds = append(removeIds, id)
+		}
+	}
+
+	return addIds, removeIds, wantedPublic && !currentPublic, currentPublic && !wantedPublic
+}
+
+// sharingPermissionsAccountIds returns the account IDs of an account_ids set.
+func sharingPermissionsAccountIds(v interface{}) []string {
+	list := v.(*schema.Set).List()
+	ids := make([]string, 0, len(list))
+	for _, id := range list {
+		ids = append(ids, id.(string))
+	}
+	return ids
+}
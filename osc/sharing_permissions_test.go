@@ -0,0 +1,61 @@
+package osc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSharingPermissionsChanges(t *testing.T) {
+	cases := map[string]struct {
+		CurrentIds    []string
+		CurrentPublic bool
+		WantedIds     []string
+		WantedPublic  bool
+		AddIds        []string
+		RemoveIds     []string
+		AddPublic     bool
+		RemovePublic  bool
+	}{
+		"nothing shared yet": {
+			WantedIds:    []string{"123456789012"},
+			WantedPublic: true,
+			AddIds:       []string{"123456789012"},
+			AddPublic:    true,
+		},
+		"shared outside of terraform": {
+			CurrentIds:    []string{"123456789012", "210987654321"},
+			CurrentPublic: true,
+			WantedIds:     []string{"123456789012"},
+			RemoveIds:     []string{"210987654321"},
+			RemovePublic:  true,
+		},
+		"unchanged": {
+			CurrentIds:    []string{"123456789012"},
+			CurrentPublic: true,
+			WantedIds:     []string{"123456789012"},
+			WantedPublic:  true,
+		},
+		"revoke everything": {
+			CurrentIds:    []string{"123456789012"},
+			CurrentPublic: true,
+			RemoveIds:     []string{"123456789012"},
+			RemovePublic:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		addIds, removeIds, addPublic, removePublic := sharingPermissionsChanges(
+			tc.CurrentIds, tc.CurrentPublic, tc.WantedIds, tc.WantedPublic)
+
+		if !reflect.DeepEqual(addIds, tc.AddIds) {
+			t.Fatalf("%s: expected to add %v, got %v", name, tc.AddIds, addIds)
+		}
+		if !reflect.DeepEqual(removeIds, tc.RemoveIds) {
+			t.Fatalf("%s: expected to remove %v, got %v", name, tc.RemoveIds, removeIds)
+		}
+		if addPublic != tc.AddPublic || removePublic != tc.RemovePublic {
+			t.Fatalf("%s: expected public add/remove %t/%t, got %t/%t",
+				name, tc.AddPublic, tc.RemovePublic, addPublic, removePublic)
+		}
+	}
+}
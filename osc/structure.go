@@ -171,6 +171,11 @@ func expandIPPerms(
 				*perm.FromPort, *perm.ToPort)
 		}
 
+		var description *string
+		if v, ok := m["description"]; ok && v.(string) != "" {
+			description = aws.String(v.(string))
+		}
+
 		var groups []string
 		if raw, ok := m["security_groups"]; ok {
 			list := raw.(*schema.Set).List()
@@ -195,7 +200,8 @@ func expandIPPerms(
 				}
 
 				perm.UserIdGroupPairs[i] = &ec2.UserIdGroupPair{
-					GroupId: aws.String(id),
+					GroupId:     aws.String(id),
+					Description: description,
 				}
 
 				if ownerId != "" {
@@ -212,14 +218,20 @@ func expandIPPerms(
 		if raw, ok := m["cidr_blocks"]; ok {
 			list := raw.([]interface{})
 			for _, v := range list {
-				perm.IpRanges = append(perm.IpRanges, &ec2.IpRange{CidrIp: aws.String(v.(string))})
+				perm.IpRanges = append(perm.IpRanges, &ec2.IpRange{
+					CidrIp:      aws.String(v.(string)),
+					Description: description,
+				})
 			}
 		}
 
 		if raw, ok := m["prefix_list_ids"]; ok {
 			list := raw.([]interface{})
 			for _, v := range list {
-				perm.PrefixListIds = append(perm.PrefixListIds, &ec2.PrefixListId{PrefixListId: aws.String(v.(string))})
+				perm.PrefixListIds = append(perm.PrefixListIds, &ec2.PrefixListId{
+					PrefixListId: aws.String(v.(string)),
+					Description:  description,
+				})
 			}
 		}
 
@@ -149,6 +149,51 @@ func TestExpandIPPerms(t *testing.T) {
 	}
 }
 
+func TestExpandIPPerms_description(t *testing.T) {
+	expanded := []interface{}{
+		map[string]interface{}{
+			"protocol":        "tcp",
+			"from_port":       443,
+			"to_port":         443,
+			"cidr_blocks":     []interface{}{"10.0.0.0/8"},
+			"prefix_list_ids": []interface{}{"pl-12345678"},
+			"security_groups": schema.NewSet(schema.HashString, []interface{}{"sg-11111"}),
+			"description":     "HTTPS",
+		},
+		map[string]interface{}{
+			"protocol":    "tcp",
+			"from_port":   80,
+			"to_port":     80,
+			"cidr_blocks": []interface{}{"10.0.0.0/8"},
+			"description": "",
+		},
+	}
+	group := &ec2.SecurityGroup{
+		GroupId: aws.String("foo"),
+		VpcId:   aws.String("bar"),
+	}
+
+	perms, err := expandIPPerms(group, expanded)
+	if err != nil {
+		t.Fatalf("error expanding perms: %v", err)
+	}
+
+	perm := perms[0]
+	for _, desc := range []*string{
+		perm.IpRanges[0].Description,
+		perm.PrefixListIds[0].Description,
+		perm.UserIdGroupPairs[0].Description,
+	} {
+		if aws.StringValue(desc) != "HTTPS" {
+			t.Fatalf("Expected description on every source, got: %s", perm)
+		}
+	}
+
+	if perms[1].IpRanges[0].Description != nil {
+		t.Fatalf("Expected no description, got: %s", perms[1])
+	}
+}
+
 func TestExpandIPPerms_NegOneProtocol(t *testing.T) {
 	hash := schema.HashString
 
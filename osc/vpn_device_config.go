@@ -0,0 +1,320 @@
+package osc
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// vpnDeviceConfigData is what the device config templates are rendered from.
+type vpnDeviceConfigData struct {
+	VpnConnectionId  string
+	StaticRoutesOnly bool
+	VpcCidrBlocks    []string
+	Tunnels          []XmlIpsecTunnel
+}
+
+// Diffie-Hellman groups as named in the customer gateway configuration
+// (group2, group14, ...) and their strongSwan names.
+var vpnDhGroupStrongswanNames = map[string]string{
+	"1":  "modp768",
+	"2":  "modp1024",
+	"5":  "modp1536",
+	"14": "modp2048",
+	"15": "modp3072",
+	"16": "modp4096",
+	"17": "modp6144",
+	"18": "modp8192",
+	"19": "ecp256",
+	"20": "ecp384",
+	"21": "ecp521",
+	"22": "modp1024s160",
+	"23": "modp2048s224",
+	"24": "modp2048s256",
+}
+
+// vpnDhGroupNumber turns "group2" into "2".
+func vpnDhGroupNumber(group string) string {
+	return strings.TrimPrefix(strings.ToLower(group), "group")
+}
+
+func vpnDhGroupStrongswan(group string) string {
+	if name, ok := vpnDhGroupStrongswanNames[vpnDhGroupNumber(group)]; ok {
+		return name
+	}
+	return group
+}
+
+// vpnCipherParts splits an encryption protocol such as "aes-128-cbc" into
+// its algorithm and key length.
+func vpnCipherParts(cipher string) (string, string) {
+	parts := strings.Split(strings.ToLower(cipher), "-")
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// vpnCipher turns "aes-128-cbc" into "aes128".
+func vpnCipher(cipher string) string {
+	name, keylen := vpnCipherParts(cipher)
+	return name + keylen
+}
+
+func vpnCipherName(cipher string) string {
+	name, _ := vpnCipherParts(cipher)
+	return name
+}
+
+func vpnCipherKeyLength(cipher string) string {
+	_, keylen := vpnCipherParts(cipher)
+	return keylen
+}
+
+// vpnHash turns both the IKE ("sha1", "sha2-256") and the IPsec
+// ("hmac-sha1-96", "hmac-sha2-256-128") authentication protocols into the
+// plain hash name, e.g. "sha1" or "sha256".
+func vpnHash(hash string) string {
+	parts := strings.Split(strings.TrimPrefix(strings.ToLower(hash), "hmac-"), "-")
+	if parts[0] == "sha2" && len(parts) > 1 {
+		return "sha" + parts[1]
+	}
+	return parts[0]
+}
+
+// vpnDpdTimeout is how long a peer may stay silent before being considered
+// dead, in seconds.
+func vpnDpdTimeout(t XmlIpsecTunnel) int {
+	return t.DpdDelay * t.DpdRetry
+}
+
+// vpnTcpMssAdjustment is the MSS TCP segments are clamped to in the tunnel,
+// 1379 when the configuration doesn't give one.
+func vpnTcpMssAdjustment(t XmlIpsecTunnel) int {
+	if t.TcpMssAdjustment == 0 {
+		return 1379
+	}
+	return t.TcpMssAdjustment
+}
+
+func vpnXmlEscape(s string) (string, error) {
+	var buf bytes.Buffer
+	if err := xml.EscapeText(&buf, []byte(s)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+var vpnDeviceConfigFuncs = template.FuncMap{
+	"inc":              func(i int) int { return i + 1 },
+	"cipher":           vpnCipher,
+	"cipherName":       vpnCipherName,
+	"cipherKeyLength":  vpnCipherKeyLength,
+	"hash":             vpnHash,
+	"dhGroup":          vpnDhGroupNumber,
+	"strongswanGroup":  vpnDhGroupStrongswan,
+	"dpdTimeout":       vpnDpdTimeout,
+	"tcpMssAdjustment": vpnTcpMssAdjustment,
+	"xml":              vpnXmlEscape,
+}
+
+// The configurations are route based: each tunnel gets its own virtual
+// tunnel interface, traffic is routed into it either with BGP or with
+// static routes to the VPC CIDR blocks.
+var vpnDeviceConfigTemplates = map[string]*template.Template{
+	"strongswan": template.Must(template.New("strongswan").Funcs(vpnDeviceConfigFuncs).Parse(
+		`# strongSwan configuration for VPN connection {{.VpnConnectionId}}
+
+# --- /etc/ipsec.conf ---
+config setup
+	uniqueids = no
+{{range $i, $t := .Tunnels}}{{$n := inc $i}}
+conn {{$.VpnConnectionId}}-{{$n}}
+	auto=start
+	type=tunnel
+	authby=secret
+	keyexchange=ikev1
+	left=%defaultroute
+	leftid={{$t.CustomerGatewayOutsideAddress}}
+	right={{$t.OutsideAddress}}
+	leftsubnet=0.0.0.0/0
+	rightsubnet=0.0.0.0/0
+	ike={{cipher $t.IkeEncryptionProtocol}}-{{hash $t.IkeAuthenticationProtocol}}-{{strongswanGroup $t.IkePfsGroup}}!
+	ikelifetime={{$t.IkeLifetime}}s
+	esp={{cipher $t.IpsecEncryptionProtocol}}-{{hash $t.IpsecAuthenticationProtocol}}-{{strongswanGroup $t.IpsecPfsGroup}}!
+	lifetime={{$t.IpsecLifetime}}s
+	dpddelay={{$t.DpdDelay}}s
+	dpdtimeout={{dpdTimeout $t}}s
+	dpdaction=restart
+	mark={{$n}}00
+	installpolicy=no
+{{end}}
+# --- /etc/ipsec.secrets ---
+{{range .Tunnels}}{{.CustomerGatewayOutsideAddress}} {{.OutsideAddress}} : PSK "{{.PreSharedKey}}"
+{{end}}
+# --- Tunnel interfaces, to run as root ---
+sysctl -w net.ipv4.conf.all.rp_filter=2
+{{range $i, $t := .Tunnels}}{{$n := inc $i}}
+ip link add vti{{$n}} type vti local {{$t.CustomerGatewayOutsideAddress}} remote {{$t.OutsideAddress}} key {{$n}}00
+ip addr add {{$t.CustomerGatewayInsideAddress}}/{{$t.InsideCidrLength}} remote {{$t.VpnGatewayInsideAddress}}/{{$t.InsideCidrLength}} dev vti{{$n}}
+ip link set vti{{$n}} up mtu 1436
+sysctl -w net.ipv4.conf.vti{{$n}}.disable_policy=1
+iptables -t mangle -A FORWARD -o vti{{$n}} -p tcp --tcp-flags SYN,RST SYN -j TCPMSS --set-mss {{tcpMssAdjustment $t}}
+{{- if $.StaticRoutesOnly}}{{range $.VpcCidrBlocks}}
+ip route add {{.}} dev vti{{$n}} metric {{$n}}00
+{{- end}}{{end}}
+{{end}}{{if not .StaticRoutesOnly}}
+# BGP is to be set up with your routing daemon of choice:
+{{range .Tunnels}}#   neighbor {{.VpnGatewayInsideAddress}} remote-as {{.VpnGatewayBgpAsn}}, local-as {{.CustomerGatewayBgpAsn}}, hold time {{.VpnGatewayBgpHoldTime}}s
+{{end}}{{end}}`)),
+
+	"vyos": template.Must(template.New("vyos").Funcs(vpnDeviceConfigFuncs).Parse(
+		`# VyOS configuration for VPN connection {{.VpnConnectionId}}
+# Replace eth0 with the interface holding the customer gateway address.
+
+set vpn ipsec ipsec-interfaces interface 'eth0'
+{{range $i, $t := .Tunnels}}{{$n := inc $i}}
+# Tunnel {{$n}}
+set vpn ipsec ike-group OSC-IKE-{{$n}} key-exchange 'ikev1'
+set vpn ipsec ike-group OSC-IKE-{{$n}} lifetime '{{$t.IkeLifetime}}'
+set vpn ipsec ike-group OSC-IKE-{{$n}} proposal 1 dh-group '{{dhGroup $t.IkePfsGroup}}'
+set vpn ipsec ike-group OSC-IKE-{{$n}} proposal 1 encryption '{{cipher $t.IkeEncryptionProtocol}}'
+set vpn ipsec ike-group OSC-IKE-{{$n}} proposal 1 hash '{{hash $t.IkeAuthenticationProtocol}}'
+set vpn ipsec ike-group OSC-IKE-{{$n}} dead-peer-detection action 'restart'
+set vpn ipsec ike-group OSC-IKE-{{$n}} dead-peer-detection interval '{{$t.DpdDelay}}'
+set vpn ipsec ike-group OSC-IKE-{{$n}} dead-peer-detection timeout '{{dpdTimeout $t}}'
+set vpn ipsec esp-group OSC-ESP-{{$n}} compression 'disable'
+set vpn ipsec esp-group OSC-ESP-{{$n}} lifetime '{{$t.IpsecLifetime}}'
+set vpn ipsec esp-group OSC-ESP-{{$n}} mode '{{$t.IpsecMode}}'
+set vpn ipsec esp-group OSC-ESP-{{$n}} pfs 'dh-group{{dhGroup $t.IpsecPfsGroup}}'
+set vpn ipsec esp-group OSC-ESP-{{$n}} proposal 1 encryption '{{cipher $t.IpsecEncryptionProtocol}}'
+set vpn ipsec esp-group OSC-ESP-{{$n}} proposal 1 hash '{{hash $t.IpsecAuthenticationProtocol}}'
+set vpn ipsec site-to-site peer {{$t.OutsideAddress}} authentication mode 'pre-shared-secret'
+set vpn ipsec site-to-site peer {{$t.OutsideAddress}} authentication pre-shared-secret '{{$t.PreSharedKey}}'
+set vpn ipsec site-to-site peer {{$t.OutsideAddress}} description '{{$.VpnConnectionId}} tunnel {{$n}}'
+set vpn ipsec site-to-site peer {{$t.OutsideAddress}} ike-group 'OSC-IKE-{{$n}}'
+set vpn ipsec site-to-site peer {{$t.OutsideAddress}} local-address '{{$t.CustomerGatewayOutsideAddress}}'
+set vpn ipsec site-to-site peer {{$t.OutsideAddress}} vti bind 'vti{{$n}}'
+set vpn ipsec site-to-site peer {{$t.OutsideAddress}} vti esp-group 'OSC-ESP-{{$n}}'
+set interfaces vti vti{{$n}} address '{{$t.CustomerGatewayInsideAddress}}/{{$t.InsideCidrLength}}'
+set interfaces vti vti{{$n}} description '{{$.VpnConnectionId}} tunnel {{$n}}'
+set interfaces vti vti{{$n}} mtu '1436'
+set firewall options interface vti{{$n}} adjust-mss '{{tcpMssAdjustment $t}}'
+{{- if $.StaticRoutesOnly}}{{range $.VpcCidrBlocks}}
+set protocols static interface-route {{.}} next-hop-interface vti{{$n}}
+{{- end}}{{else}}
+set protocols bgp {{$t.CustomerGatewayBgpAsn}} neighbor {{$t.VpnGatewayInsideAddress}} remote-as '{{$t.VpnGatewayBgpAsn}}'
+set protocols bgp {{$t.CustomerGatewayBgpAsn}} neighbor {{$t.VpnGatewayInsideAddress}} soft-reconfiguration 'inbound'
+set protocols bgp {{$t.CustomerGatewayBgpAsn}} neighbor {{$t.VpnGatewayInsideAddress}} timers holdtime '{{$t.VpnGatewayBgpHoldTime}}'
+{{- end}}
+{{end}}`)),
+
+	"pfsense": template.Must(template.New("pfsense").Funcs(vpnDeviceConfigFuncs).Parse(
+		`<!-- pfSense IPsec configuration for VPN connection {{.VpnConnectionId}}, to merge into config.xml -->
+<ipsec>
+{{- range $i, $t := .Tunnels}}{{$n := inc $i}}
+	<phase1>
+		<ikeid>{{$n}}</ikeid>
+		<iketype>ikev1</iketype>
+		<mode>{{$t.IkeMode}}</mode>
+		<interface>wan</interface>
+		<remote-gateway>{{$t.OutsideAddress}}</remote-gateway>
+		<protocol>inet</protocol>
+		<myid_type>myaddress</myid_type>
+		<myid_data></myid_data>
+		<peerid_type>peeraddress</peerid_type>
+		<peerid_data></peerid_data>
+		<encryption-algorithm>
+			<name>{{cipherName $t.IkeEncryptionProtocol}}</name>
+			<keylen>{{cipherKeyLength $t.IkeEncryptionProtocol}}</keylen>
+		</encryption-algorithm>
+		<hash-algorithm>{{hash $t.IkeAuthenticationProtocol}}</hash-algorithm>
+		<dhgroup>{{dhGroup $t.IkePfsGroup}}</dhgroup>
+		<lifetime>{{$t.IkeLifetime}}</lifetime>
+		<pre-shared-key>{{xml $t.PreSharedKey}}</pre-shared-key>
+		<authentication_method>pre_shared_key</authentication_method>
+		<descr>{{$.VpnConnectionId}} tunnel {{$n}}</descr>
+		<nat_traversal>on</nat_traversal>
+		<dpd_delay>{{$t.DpdDelay}}</dpd_delay>
+		<dpd_maxfail>{{$t.DpdRetry}}</dpd_maxfail>
+	</phase1>
+	<phase2>
+		<ikeid>{{$n}}</ikeid>
+		<mode>vti</mode>
+		<localid>
+			<type>network</type>
+			<address>{{$t.CustomerGatewayInsideAddress}}</address>
+			<netbits>{{$t.InsideCidrLength}}</netbits>
+		</localid>
+		<remoteid>
+			<type>address</type>
+			<address>{{$t.VpnGatewayInsideAddress}}</address>
+		</remoteid>
+		<protocol>{{$t.IpsecProtocol}}</protocol>
+		<encryption-algorithm-option>
+			<name>{{cipherName $t.IpsecEncryptionProtocol}}</name>
+			<keylen>{{cipherKeyLength $t.IpsecEncryptionProtocol}}</keylen>
+		</encryption-algorithm-option>
+		<hash-algorithm-option>hmac_{{hash $t.IpsecAuthenticationProtocol}}</hash-algorithm-option>
+		<pfsgroup>{{dhGroup $t.IpsecPfsGroup}}</pfsgroup>
+		<lifetime>{{$t.IpsecLifetime}}</lifetime>
+		<descr>{{$.VpnConnectionId}} tunnel {{$n}}</descr>
+	</phase2>
+{{- end}}
+</ipsec>
+<!--
+{{- if .StaticRoutesOnly}}
+Once the VTI interfaces are assigned, add static routes to
+{{- range .VpcCidrBlocks}} {{.}}{{end}} through the gateway of each tunnel:
+{{- range $i, $t := .Tunnels}}
+  ipsec{{inc $i}}000 via {{$t.VpnGatewayInsideAddress}}
+{{- end}}
+{{- else}}
+Configure the OpenBGPD package with the following neighbors:
+{{- range .Tunnels}}
+  {{.VpnGatewayInsideAddress}} remote-as {{.VpnGatewayBgpAsn}}, local-as {{.CustomerGatewayBgpAsn}}, holdtime {{.VpnGatewayBgpHoldTime}}
+{{- end}}
+{{- end}}
+-->
+`)),
+}
+
+// vpnDeviceTypes returns the supported device types, sorted.
+func vpnDeviceTypes() []string {
+	types := make([]string, 0, len(vpnDeviceConfigTemplates))
+	for t := range vpnDeviceConfigTemplates {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func validateVpnDeviceType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, ok := vpnDeviceConfigTemplates[value]; !ok {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of %s, got %q", k, strings.Join(vpnDeviceTypes(), ", "), value))
+	}
+	return
+}
+
+// renderVpnDeviceConfig renders the configuration of the given device type
+// for a VPN connection.
+func renderVpnDeviceConfig(deviceType string, data *vpnDeviceConfigData) (string, error) {
+	tmpl, ok := vpnDeviceConfigTemplates[deviceType]
+	if !ok {
+		return "", fmt.Errorf("Unsupported device type %q, expected one of %s",
+			deviceType, strings.Join(vpnDeviceTypes(), ", "))
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("Error rendering %s configuration for VPN connection %s: %s",
+			deviceType, data.VpnConnectionId, err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,140 @@
+package osc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVpnDeviceConfigHelpers(t *testing.T) {
+	cases := []struct {
+		Func     func(string) string
+		Input    string
+		Expected string
+	}{
+		{vpnCipher, "aes-128-cbc", "aes128"},
+		{vpnCipher, "aes-256-cbc", "aes256"},
+		{vpnCipherName, "aes-256-cbc", "aes"},
+		{vpnCipherKeyLength, "aes-256-cbc", "256"},
+		{vpnHash, "sha1", "sha1"},
+		{vpnHash, "hmac-sha1-96", "sha1"},
+		{vpnHash, "sha2-256", "sha256"},
+		{vpnHash, "hmac-sha2-256-128", "sha256"},
+		{vpnDhGroupNumber, "group2", "2"},
+		{vpnDhGroupNumber, "group14", "14"},
+		{vpnDhGroupStrongswan, "group2", "modp1024"},
+		{vpnDhGroupStrongswan, "group20", "ecp384"},
+	}
+
+	for _, tc := range cases {
+		if got := tc.Func(tc.Input); got != tc.Expected {
+			t.Fatalf("%q: expected %q, got %q", tc.Input, tc.Expected, got)
+		}
+	}
+}
+
+func TestRenderVpnDeviceConfig(t *testing.T) {
+	vpnConfig, err := xmlConfigToVpnConnectionConfig(testAccAwsVpnFullTunnelXML)
+	if err != nil {
+		t.Fatalf("Error unmarshalling XML: %s", err)
+	}
+
+	cases := []struct {
+		DeviceType       string
+		StaticRoutesOnly bool
+		Expected         []string
+	}{
+		{
+			DeviceType: "strongswan",
+			Expected: []string{
+				"conn vpn-abc123-1\n",
+				"\tright=198.51.100.10\n",
+				"\tike=aes128-sha1-modp1024!\n",
+				"\tesp=aes128-sha1-modp1024!\n",
+				"\tdpdtimeout=30s\n",
+				"203.0.113.1 198.51.100.20 : PSK \"SECOND_KEY\"\n",
+				"ip addr add 169.254.44.6/30 remote 169.254.44.5/30 dev vti1\n",
+				"--set-mss 1387\n",
+				"#   neighbor 169.254.45.1 remote-as 50624, local-as 65000",
+			},
+		},
+		{
+			DeviceType:       "strongswan",
+			StaticRoutesOnly: true,
+			Expected: []string{
+				"ip route add 10.0.0.0/16 dev vti1 metric 100\n",
+				"ip route add 10.0.0.0/16 dev vti2 metric 200\n",
+			},
+		},
+		{
+			DeviceType: "vyos",
+			Expected: []string{
+				"set vpn ipsec ike-group OSC-IKE-1 proposal 1 dh-group '2'\n",
+				"set vpn ipsec esp-group OSC-ESP-2 pfs 'dh-group2'\n",
+				"set vpn ipsec site-to-site peer 198.51.100.10 authentication pre-shared-secret 'FIRST_KEY'\n",
+				"set interfaces vti vti2 address '169.254.45.2/30'\n",
+				"set firewall options interface vti1 adjust-mss '1387'\n",
+				"set protocols bgp 65000 neighbor 169.254.44.5 remote-as '50624'\n",
+			},
+		},
+		{
+			DeviceType:       "vyos",
+			StaticRoutesOnly: true,
+			Expected: []string{
+				"set protocols static interface-route 10.0.0.0/16 next-hop-interface vti1\n",
+			},
+		},
+		{
+			DeviceType: "pfsense",
+			Expected: []string{
+				"<remote-gateway>198.51.100.10</remote-gateway>",
+				"<pre-shared-key>FIRST_KEY</pre-shared-key>",
+				"<hash-algorithm-option>hmac_sha1</hash-algorithm-option>",
+				"<address>169.254.45.2</address>",
+				"169.254.45.1 remote-as 50624, local-as 65000, holdtime 30",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		config, err := renderVpnDeviceConfig(tc.DeviceType, &vpnDeviceConfigData{
+			VpnConnectionId:  "vpn-abc123",
+			StaticRoutesOnly: tc.StaticRoutesOnly,
+			VpcCidrBlocks:    []string{"10.0.0.0/16"},
+			Tunnels:          vpnConfig.Tunnels,
+		})
+		if err != nil {
+			t.Fatalf("%s: %s", tc.DeviceType, err)
+		}
+
+		for _, e := range tc.Expected {
+			if !strings.Contains(config, e) {
+				t.Fatalf("%s: expected %q in:\n%s", tc.DeviceType, e, config)
+			}
+		}
+	}
+
+	// Without tcp_mss_adjustment in the XML, the MSS falls back to 1379.
+	tunnels := append([]XmlIpsecTunnel{}, vpnConfig.Tunnels...)
+	for i := range tunnels {
+		tunnels[i].TcpMssAdjustment = 0
+	}
+	for deviceType, expected := range map[string]string{
+		"strongswan": "--set-mss 1379\n",
+		"vyos":       "set firewall options interface vti1 adjust-mss '1379'\n",
+	} {
+		config, err := renderVpnDeviceConfig(deviceType, &vpnDeviceConfigData{
+			VpnConnectionId: "vpn-abc123",
+			Tunnels:         tunnels,
+		})
+		if err != nil {
+			t.Fatalf("%s: %s", deviceType, err)
+		}
+		if !strings.Contains(config, expected) {
+			t.Fatalf("%s: expected %q in:\n%s", deviceType, expected, config)
+		}
+	}
+
+	if _, err := renderVpnDeviceConfig("cisco", &vpnDeviceConfigData{}); err == nil {
+		t.Fatalf("Expected an error for an unsupported device type")
+	}
+}